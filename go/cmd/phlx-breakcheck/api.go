@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// apiField is one exported field of a contract struct
+type apiField struct {
+	Name      string // Go field name
+	JSONName  string // name on the wire, "-" if not serialized
+	Type      string // type expression as written in source
+	OmitEmpty bool
+	Embedded  bool
+}
+
+// optional reports whether consumers may see the field absent from JSON
+func (f apiField) optional() bool {
+	return f.OmitEmpty
+}
+
+// apiStruct is an exported struct declaration
+type apiStruct struct {
+	Fields []apiField
+}
+
+// apiConst is an exported constant declaration
+type apiConst struct {
+	Type  string
+	Value string
+}
+
+// apiSurface is the exported contract surface of a set of packages,
+// keyed by "pkg.Name"
+type apiSurface struct {
+	Structs map[string]apiStruct
+	Types   map[string]string // non-struct named types → underlying type expression
+	Consts  map[string]apiConst
+}
+
+func newSurface() *apiSurface {
+	return &apiSurface{
+		Structs: map[string]apiStruct{},
+		Types:   map[string]string{},
+		Consts:  map[string]apiConst{},
+	}
+}
+
+// addFile parses one Go source file into the surface
+func (s *apiSurface) addFile(filename string, src []byte) error {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return err
+	}
+	pkg := file.Name.Name
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		switch gen.Tok {
+		case token.TYPE:
+			for _, spec := range gen.Specs {
+				s.addType(pkg, spec.(*ast.TypeSpec))
+			}
+		case token.CONST:
+			s.addConsts(pkg, gen)
+		}
+	}
+	return nil
+}
+
+func (s *apiSurface) addType(pkg string, spec *ast.TypeSpec) {
+	if !spec.Name.IsExported() {
+		return
+	}
+	key := pkg + "." + spec.Name.Name
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		s.Types[key] = types.ExprString(spec.Type)
+		return
+	}
+
+	var fields []apiField
+	for _, f := range st.Fields.List {
+		typ := types.ExprString(f.Type)
+		jsonName, omitEmpty := "", false
+		if f.Tag != nil {
+			if tag, err := strconv.Unquote(f.Tag.Value); err == nil {
+				name, opts, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+				jsonName = name
+				omitEmpty = strings.Contains(","+opts+",", ",omitempty,")
+			}
+		}
+
+		if len(f.Names) == 0 {
+			// Embedded field: named after its type
+			name := strings.TrimPrefix(typ, "*")
+			name = name[strings.LastIndex(name, ".")+1:]
+			fields = append(fields, apiField{Name: name, JSONName: jsonName, Type: typ, OmitEmpty: omitEmpty, Embedded: true})
+			continue
+		}
+		for _, n := range f.Names {
+			if !n.IsExported() {
+				continue
+			}
+			jn := jsonName
+			if jn == "" {
+				jn = n.Name
+			}
+			fields = append(fields, apiField{Name: n.Name, JSONName: jn, Type: typ, OmitEmpty: omitEmpty})
+		}
+	}
+	s.Structs[key] = apiStruct{Fields: fields}
+}
+
+// addConsts records exported constants; implicit repetitions in a const block
+// inherit the previous type and expression, with iota resolved to its index
+func (s *apiSurface) addConsts(pkg string, gen *ast.GenDecl) {
+	var lastType string
+	var lastValues []ast.Expr
+	for i, spec := range gen.Specs {
+		vs := spec.(*ast.ValueSpec)
+		if vs.Values != nil {
+			lastValues = vs.Values
+			lastType = ""
+			if vs.Type != nil {
+				lastType = types.ExprString(vs.Type)
+			}
+		}
+		for j, name := range vs.Names {
+			if !name.IsExported() || j >= len(lastValues) {
+				continue
+			}
+			value := types.ExprString(lastValues[j])
+			if strings.Contains(value, "iota") {
+				value = fmt.Sprintf("%s (iota=%d)", value, i)
+			}
+			s.Consts[pkg+"."+name.Name] = apiConst{Type: lastType, Value: value}
+		}
+	}
+}
+
+// isGoSource reports whether a repository path is a non-test Go file
+func isGoSource(p string) bool {
+	return path.Ext(p) == ".go" && !strings.HasSuffix(p, "_test.go")
+}
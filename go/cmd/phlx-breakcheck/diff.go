@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Level is the semver component a change requires, per VERSIONING.md
+type Level int
+
+const (
+	Patch Level = iota
+	Minor
+	Major
+)
+
+func (l Level) String() string {
+	switch l {
+	case Major:
+		return "MAJOR"
+	case Minor:
+		return "MINOR"
+	default:
+		return "PATCH"
+	}
+}
+
+// Change is one classified difference between two contract surfaces
+type Change struct {
+	Level  Level
+	Symbol string
+	Detail string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%-5s  %s: %s", c.Level, c.Symbol, c.Detail)
+}
+
+// Diff classifies every difference between the old and new surfaces
+func Diff(before, after *apiSurface) []Change {
+	var changes []Change
+	add := func(level Level, symbol, format string, args ...any) {
+		changes = append(changes, Change{Level: level, Symbol: symbol, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	for name, bs := range before.Structs {
+		as, ok := after.Structs[name]
+		if !ok {
+			add(Major, name, "struct removed")
+			continue
+		}
+		for _, c := range diffFields(bs, as) {
+			add(c.Level, name, "%s", c.Detail)
+		}
+	}
+	for name := range after.Structs {
+		if _, ok := before.Structs[name]; !ok {
+			add(Minor, name, "struct added")
+		}
+	}
+
+	for name, ot := range before.Types {
+		nt, ok := after.Types[name]
+		switch {
+		case !ok:
+			add(Major, name, "type removed")
+		case ot != nt:
+			add(Major, name, "type changed from %s to %s", ot, nt)
+		}
+	}
+	for name := range after.Types {
+		if _, ok := before.Types[name]; !ok {
+			add(Minor, name, "type added")
+		}
+	}
+
+	for name, oc := range before.Consts {
+		nc, ok := after.Consts[name]
+		switch {
+		case !ok:
+			add(Major, name, "constant removed")
+		case oc.Value != nc.Value:
+			add(Major, name, "constant value changed from %s to %s", oc.Value, nc.Value)
+		case oc.Type != nc.Type:
+			add(Major, name, "constant type changed from %q to %q", oc.Type, nc.Type)
+		}
+	}
+	for name := range after.Consts {
+		if _, ok := before.Consts[name]; !ok {
+			add(Minor, name, "constant added")
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Level != changes[j].Level {
+			return changes[i].Level > changes[j].Level
+		}
+		if changes[i].Symbol != changes[j].Symbol {
+			return changes[i].Symbol < changes[j].Symbol
+		}
+		return changes[i].Detail < changes[j].Detail
+	})
+	return changes
+}
+
+// diffFields compares the fields of one struct. A removed field paired with an
+// added field of the same type is reported as a rename.
+func diffFields(before, after apiStruct) []Change {
+	var changes []Change
+	add := func(level Level, format string, args ...any) {
+		changes = append(changes, Change{Level: level, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	newByName := map[string]apiField{}
+	for _, f := range after.Fields {
+		newByName[f.Name] = f
+	}
+	oldByName := map[string]apiField{}
+	for _, f := range before.Fields {
+		oldByName[f.Name] = f
+	}
+
+	var removed, added []apiField
+	for _, of := range before.Fields {
+		nf, ok := newByName[of.Name]
+		if !ok {
+			removed = append(removed, of)
+			continue
+		}
+		if of.Type != nf.Type {
+			add(Major, "field %s type changed from %s to %s", of.Name, of.Type, nf.Type)
+		}
+		if of.JSONName != nf.JSONName {
+			add(Major, "field %s renamed on the wire from %q to %q", of.Name, of.JSONName, nf.JSONName)
+		}
+		switch {
+		case of.optional() && !nf.optional():
+			add(Major, "field %s changed from optional to required", of.Name)
+		case !of.optional() && nf.optional():
+			add(Minor, "field %s changed from required to optional", of.Name)
+		}
+	}
+	for _, nf := range after.Fields {
+		if _, ok := oldByName[nf.Name]; !ok {
+			added = append(added, nf)
+		}
+	}
+
+	for _, rf := range removed {
+		renamed := false
+		for i, af := range added {
+			if af.Type == rf.Type && af.OmitEmpty == rf.OmitEmpty {
+				add(Major, "field %s renamed to %s", rf.Name, af.Name)
+				added = append(added[:i], added[i+1:]...)
+				renamed = true
+				break
+			}
+		}
+		if !renamed {
+			add(Major, "field %s removed", rf.Name)
+		}
+	}
+	for _, af := range added {
+		switch {
+		case af.Embedded:
+			add(Major, "embedded %s added; its fields become required", af.Type)
+		case af.optional():
+			add(Minor, "optional field %s added", af.Name)
+		default:
+			add(Major, "required field %s added", af.Name)
+		}
+	}
+	return changes
+}
+
+// Required returns the highest level among the changes
+func Required(changes []Change) Level {
+	level := Patch
+	for _, c := range changes {
+		if c.Level > level {
+			level = c.Level
+		}
+	}
+	return level
+}
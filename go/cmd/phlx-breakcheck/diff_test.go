@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func surfaceOf(t *testing.T, src string) *apiSurface {
+	t.Helper()
+	s := newSurface()
+	if err := s.addFile("go/models/contract.go", []byte("package models\n\n"+src)); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// TestDiffRules covers the table in VERSIONING.md
+func TestDiffRules(t *testing.T) {
+	const base = "type Asset struct {\n\tID string `json:\"id\"`\n\tName string `json:\"name,omitempty\"`\n}\n" +
+		"type Status string\n\nconst StatusActive Status = \"active\"\n"
+	for _, tt := range []struct {
+		name, after string
+		want        Level
+		detail      string
+	}{
+		{"struct removed", "type Status string\n\nconst StatusActive Status = \"active\"\n", Major, "struct removed"},
+		{"field removed", strings.Replace(base, "\tName string `json:\"name,omitempty\"`\n", "", 1), Major, "field Name removed"},
+		{"field type changed", strings.Replace(base, "Name string", "Name int", 1), Major, "field Name type changed"},
+		{"JSON name changed", strings.Replace(base, `"name,omitempty"`, `"label,omitempty"`, 1), Major, "renamed on the wire"},
+		{"field renamed", strings.Replace(base, "Name string `json:\"name,omitempty\"`", "Label string `json:\"label,omitempty\"`", 1), Major, "field Name renamed to Label"},
+		{"omitempty removed", strings.Replace(base, `"name,omitempty"`, `"name"`, 1), Major, "optional to required"},
+		{"required field added", strings.Replace(base, "}\n", "\tTeam string `json:\"team\"`\n}\n", 1), Major, "required field Team added"},
+		{"constant value changed", strings.Replace(base, `"active"`, `"ACTIVE"`, 1), Major, "constant value changed"},
+		{"type changed", strings.Replace(base, "type Status string", "type Status int", 1), Major, "type changed"},
+		{"optional field added", strings.Replace(base, "}\n", "\tTeam *string `json:\"team,omitempty\"`\n}\n", 1), Minor, "optional field Team added"},
+		{"omitempty added", strings.Replace(base, `"id"`, `"id,omitempty"`, 1), Minor, "required to optional"},
+		{"constant added", base + "const StatusIdle Status = \"idle\"\n", Minor, "constant added"},
+		{"struct added", base + "type Team struct{}\n", Minor, "struct added"},
+		{"comments only", "// Asset is a responder\n" + base, Patch, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := Diff(surfaceOf(t, base), surfaceOf(t, tt.after))
+			if got := Required(changes); got != tt.want {
+				t.Errorf("required %s, want %s: %v", got, tt.want, changes)
+			}
+			if tt.detail == "" {
+				if len(changes) != 0 {
+					t.Errorf("changes %v, want none", changes)
+				}
+				return
+			}
+			if len(changes) == 0 || !strings.Contains(changes[0].Detail, tt.detail) {
+				t.Errorf("changes %v, want %q first", changes, tt.detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+// Command phlx-breakcheck compares the exported structs, named types and constants of
+// go/models and go/events between two git revisions, classifies each change with the
+// rules in VERSIONING.md and reports the semver bump the change set requires.
+//
+// Usage (anywhere inside the repository):
+//
+//	go run ./cmd/phlx-breakcheck -from go/v1.2.0
+//	go run ./cmd/phlx-breakcheck -from origin/main -to HEAD -to-version 2.0.0
+//
+// Versions default to the go/vX.Y.Z tag pointing at each revision, else to the go/VERSION
+// file at it, so a pull request declares its bump by editing go/VERSION. The command exits
+// with status 1 when the version bump between the revisions is smaller than the changes
+// require (for example a breaking change without a major bump) or when the revisions
+// change the contracts and a version cannot be resolved, and 2 on usage errors.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+const (
+	// tagPrefix is the prefix of Go module release tags, see VERSIONING.md
+	tagPrefix = "go/v"
+	// versionFile holds the version of the Go module, relative to the repository root
+	versionFile = "go/VERSION"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command and returns its exit status
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("phlx-breakcheck", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	from := flags.String("from", "", "base git revision, e.g. go/v1.2.0 or origin/main (required)")
+	to := flags.String("to", "HEAD", "head git revision")
+	fromVersion := flags.String("from-version", "", "version of the base revision (default: its go/v* tag or "+versionFile+")")
+	toVersion := flags.String("to-version", "", "version of the head revision (default: its go/v* tag or "+versionFile+")")
+	pkgs := flags.String("pkgs", "go/models,go/events", "comma-separated package directories, relative to the repository root")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *from == "" {
+		fmt.Fprintln(stderr, "phlx-breakcheck: -from is required")
+		flags.Usage()
+		return 2
+	}
+	fail := func(err error) int {
+		fmt.Fprintln(stderr, "phlx-breakcheck:", err)
+		return 2
+	}
+
+	dirs := strings.Split(*pkgs, ",")
+	before, err := loadSurface(*from, dirs)
+	if err != nil {
+		return fail(err)
+	}
+	after, err := loadSurface(*to, dirs)
+	if err != nil {
+		return fail(err)
+	}
+
+	changes := Diff(before, after)
+	for _, c := range changes {
+		fmt.Fprintln(stdout, c)
+	}
+	required := Required(changes)
+	if len(changes) == 0 {
+		fmt.Fprintln(stdout, "no contract changes")
+		return 0
+	}
+
+	base, err := resolveVersion(*fromVersion, *from)
+	if err != nil {
+		return fail(err)
+	}
+	if base == nil {
+		fmt.Fprintf(stdout, "\nrequired bump: %s\n", required)
+		fmt.Fprintf(stdout, "no go/v* tag or %s on %s; pass -from-version\n", versionFile, *from)
+		return 1
+	}
+	fmt.Fprintf(stdout, "\nrequired bump: %s (%s → %s or later)\n", required, base, base.bump(required))
+	head, err := resolveVersion(*toVersion, *to)
+	if err != nil {
+		return fail(err)
+	}
+	if head == nil {
+		fmt.Fprintf(stdout, "no go/v* tag or %s on %s; pass -to-version\n", versionFile, *to)
+		return 1
+	}
+	if actual := base.levelTo(*head); actual < required {
+		fmt.Fprintf(stdout, "version %s → %s is a %s bump, but the changes require %s\n", base, head, actual, required)
+		return 1
+	}
+	return 0
+}
+
+// git runs a git command and returns its stdout
+func git(args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// loadSurface parses the Go sources of the package directories at a revision
+func loadSurface(rev string, dirs []string) (*apiSurface, error) {
+	root, err := git("rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+	top := strings.TrimSpace(string(root))
+
+	surface := newSurface()
+	for _, dir := range dirs {
+		dir = strings.Trim(strings.TrimSpace(dir), "/")
+		list, err := git("-C", top, "ls-tree", "--name-only", rev+":"+dir)
+		if err != nil {
+			// The package did not exist at this revision
+			continue
+		}
+		for _, name := range strings.Fields(string(list)) {
+			p := dir + "/" + name
+			if !isGoSource(p) {
+				continue
+			}
+			src, err := git("-C", top, "show", rev+":"+p)
+			if err != nil {
+				return nil, err
+			}
+			if err := surface.addFile(p, src); err != nil {
+				return nil, fmt.Errorf("%s at %s: %w", p, rev, err)
+			}
+		}
+	}
+	return surface, nil
+}
+
+// version is a parsed MAJOR.MINOR.PATCH
+type version struct {
+	major, minor, patch int
+}
+
+func (v version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
+
+func parseVersion(s string) (version, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, tagPrefix), "v")
+	s, _, _ = strings.Cut(s, "-") // ignore pre-release suffixes
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return version{}, fmt.Errorf("invalid version %q, want MAJOR.MINOR.PATCH", s)
+	}
+	var nums [3]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return version{}, fmt.Errorf("invalid version %q", s)
+		}
+		nums[i] = n
+	}
+	return version{nums[0], nums[1], nums[2]}, nil
+}
+
+// resolveVersion uses the explicit version if given, else the go/v* tag on the revision,
+// else the version file at the revision. It returns nil when none is available.
+func resolveVersion(explicit, rev string) (*version, error) {
+	if explicit != "" {
+		v, err := parseVersion(explicit)
+		return &v, err
+	}
+	out, err := git("tag", "--points-at", rev, "--list", tagPrefix+"*")
+	if err != nil {
+		return nil, err
+	}
+	var best *version
+	for _, tag := range strings.Fields(string(out)) {
+		v, err := parseVersion(tag)
+		if err != nil {
+			continue
+		}
+		if best == nil || best.less(v) {
+			best = &v
+		}
+	}
+	if best != nil {
+		return best, nil
+	}
+	data, err := git("show", rev+":"+versionFile)
+	if err != nil {
+		// No version file at this revision
+		return nil, nil
+	}
+	v, err := parseVersion(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%s at %s: %w", versionFile, rev, err)
+	}
+	return &v, nil
+}
+
+func (v version) less(o version) bool {
+	if v.major != o.major {
+		return v.major < o.major
+	}
+	if v.minor != o.minor {
+		return v.minor < o.minor
+	}
+	return v.patch < o.patch
+}
+
+// levelTo returns the size of the bump from v to o; going backwards counts as no bump
+func (v version) levelTo(o version) Level {
+	switch {
+	case o.major > v.major:
+		return Major
+	case o.major == v.major && o.minor > v.minor:
+		return Minor
+	default:
+		return Patch
+	}
+}
+
+// bump returns the smallest version after v that satisfies the level
+func (v version) bump(l Level) version {
+	switch l {
+	case Major:
+		return version{v.major + 1, 0, 0}
+	case Minor:
+		return version{v.major, v.minor + 1, 0}
+	default:
+		return version{v.major, v.minor, v.patch + 1}
+	}
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	for in, want := range map[string]version{
+		"1.2.3":         {1, 2, 3},
+		"v1.2.3":        {1, 2, 3},
+		"go/v2.0.0":     {2, 0, 0},
+		"1.4.0-rc.1":    {1, 4, 0},
+		"go/v10.20.30":  {10, 20, 30},
+		"0.0.1-alpha.2": {0, 0, 1},
+	} {
+		if got, err := parseVersion(in); err != nil || got != want {
+			t.Errorf("parseVersion(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "1.2", "1.2.3.4", "1.x.0", "1.-2.0"} {
+		if _, err := parseVersion(in); err == nil {
+			t.Errorf("parseVersion(%q) succeeded", in)
+		}
+	}
+}
+
+func TestVersionLevels(t *testing.T) {
+	base := version{1, 2, 3}
+	for _, tt := range []struct {
+		to   version
+		want Level
+	}{
+		{version{2, 0, 0}, Major},
+		{version{1, 3, 0}, Minor},
+		{version{1, 2, 4}, Patch},
+		{version{1, 2, 3}, Patch},
+		{version{1, 1, 0}, Patch},
+	} {
+		if got := base.levelTo(tt.to); got != tt.want {
+			t.Errorf("%s → %s is %s, want %s", base, tt.to, got, tt.want)
+		}
+	}
+	for level, want := range map[Level]version{Major: {2, 0, 0}, Minor: {1, 3, 0}, Patch: {1, 2, 4}} {
+		if got := base.bump(level); got != want {
+			t.Errorf("bump(%s) = %s, want %s", level, got, want)
+		}
+	}
+}
+
+// contractRepo is a scratch git repository with a go/models package
+type contractRepo struct {
+	t   *testing.T
+	dir string
+}
+
+// newContractRepo creates a repository and makes it the working directory
+func newContractRepo(t *testing.T) *contractRepo {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	for _, k := range []string{"GIT_AUTHOR_NAME", "GIT_COMMITTER_NAME"} {
+		t.Setenv(k, "test")
+	}
+	for _, k := range []string{"GIT_AUTHOR_EMAIL", "GIT_COMMITTER_EMAIL"} {
+		t.Setenv(k, "test@example.org")
+	}
+	r := &contractRepo{t: t, dir: dir}
+	r.git("init", "-q")
+	return r
+}
+
+func (r *contractRepo) git(args ...string) {
+	r.t.Helper()
+	if _, err := git(append([]string{"-C", r.dir}, args...)...); err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+// commit writes the files, given as path and content pairs, and commits them
+func (r *contractRepo) commit(files ...string) {
+	r.t.Helper()
+	for i := 0; i < len(files); i += 2 {
+		path := filepath.Join(r.dir, files[i])
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			r.t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(files[i+1]), 0o644); err != nil {
+			r.t.Fatal(err)
+		}
+	}
+	r.git("add", "-A")
+	r.git("commit", "-q", "-m", "change")
+}
+
+func asset(fields string) string {
+	return "package models\n\ntype Asset struct {\n\tID string `json:\"id\"`\n" + fields + "}\n"
+}
+
+func breakcheck(args ...string) (int, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String() + stderr.String()
+}
+
+func TestRunChecksVersionFile(t *testing.T) {
+	r := newContractRepo(t)
+	r.commit("go/models/asset.go", asset(""), versionFile, "1.0.0\n")
+	r.git("tag", "go/v1.0.0")
+	r.git("branch", "main")
+
+	// A pull request head is untagged: its version comes from the version file
+	r.commit("go/models/asset.go", asset("\tName string `json:\"name,omitempty\"`\n"))
+	if code, out := breakcheck("-from", "main"); code != 1 || !strings.Contains(out, "1.0.0 → 1.0.0 is a PATCH bump, but the changes require MINOR") {
+		t.Errorf("optional field without a bump: exit %d\n%s", code, out)
+	}
+	r.commit(versionFile, "1.1.0\n")
+	if code, out := breakcheck("-from", "main"); code != 0 || !strings.Contains(out, "required bump: MINOR (1.0.0 → 1.1.0 or later)") {
+		t.Errorf("optional field with a minor bump: exit %d\n%s", code, out)
+	}
+
+	r.commit("go/models/asset.go", asset("\tName string `json:\"name\"`\n"))
+	if code, out := breakcheck("-from", "main"); code != 1 || !strings.Contains(out, "required field Name added") {
+		t.Errorf("breaking change with a minor bump: exit %d\n%s", code, out)
+	}
+	if code, out := breakcheck("-from", "main", "-to-version", "2.0.0"); code != 0 {
+		t.Errorf("-to-version overrides the version file: exit %d\n%s", code, out)
+	}
+}
+
+func TestRunWithDeletedVersionFile(t *testing.T) {
+	r := newContractRepo(t)
+	r.commit("go/models/asset.go", asset(""), versionFile, "1.0.0\n")
+	r.git("branch", "main")
+	r.commit("go/models/asset.go", asset("\tName string `json:\"name\"`\n"))
+	r.git("rm", "-q", versionFile)
+	r.git("commit", "-q", "-m", "drop version")
+
+	if code, out := breakcheck("-from", "main"); code != 1 || !strings.Contains(out, "required field Name added") {
+		t.Errorf("breaking change without a version file: exit %d\n%s", code, out)
+	}
+}
+
+func TestRunWithoutVersions(t *testing.T) {
+	r := newContractRepo(t)
+	r.commit("go/models/asset.go", asset(""))
+	r.git("branch", "main")
+	r.commit("go/models/asset.go", asset("\tName string `json:\"name,omitempty\"`\n"))
+
+	// Contract changes fail the check until both versions are known
+	if code, out := breakcheck("-from", "main"); code != 1 || !strings.Contains(out, "no go/v* tag or go/VERSION on main; pass -from-version") {
+		t.Errorf("untagged base: exit %d\n%s", code, out)
+	}
+	if code, out := breakcheck("-from", "main", "-from-version", "1.0.0"); code != 1 || !strings.Contains(out, "no go/v* tag or go/VERSION on HEAD; pass -to-version") {
+		t.Errorf("unversioned head: exit %d\n%s", code, out)
+	}
+	if code, out := breakcheck("-from", "main", "-from-version", "1.0.0", "-to-version", "1.1.0"); code != 0 {
+		t.Errorf("explicit versions: exit %d\n%s", code, out)
+	}
+	if code, out := breakcheck("-from", "HEAD"); code != 0 || !strings.Contains(out, "no contract changes") {
+		t.Errorf("same revision: exit %d\n%s", code, out)
+	}
+
+	r.commit(versionFile, "one\n")
+	if code, _ := breakcheck("-from", "main", "-from-version", "1.0.0"); code != 2 {
+		t.Errorf("invalid version file: exit %d, want 2", code)
+	}
+	if code, _ := breakcheck(); code != 2 {
+		t.Errorf("missing -from: exit %d, want 2", code)
+	}
+}
@@ -0,0 +1,73 @@
+// Command phlx-fixtures writes the golden JSON corpus: one canonical sample per event
+// type and per model, plus its zero value, for the Go, TypeScript and Python packages
+// to check their types against. See internal/fixtures.
+//
+// Usage (from the go/ directory):
+//
+//	go run ./cmd/phlx-fixtures
+//
+// -out defaults to the repository's testdata/fixtures. Output is deterministic.
+// go test ./internal/fixtures fails when the committed corpus is stale, so regenerate
+// it after changing a model or event.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/ai-project-787/phlx-contracts/go/internal/fixtures"
+)
+
+func main() {
+	out := flag.String("out", "../testdata/fixtures", "output directory")
+	flag.Parse()
+
+	files, err := fixtures.Files()
+	if err != nil {
+		fail(err)
+	}
+	if err := removeStale(*out, files); err != nil {
+		fail(err)
+	}
+	for rel, data := range files {
+		target := filepath.Join(*out, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			fail(err)
+		}
+		if err := os.WriteFile(target, data, 0o644); err != nil {
+			fail(err)
+		}
+	}
+	fmt.Printf("phlx-fixtures: wrote %d files to %s\n", len(files), *out)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "phlx-fixtures:", err)
+	os.Exit(1)
+}
+
+// removeStale deletes JSON files the corpus no longer contains, e.g. of a removed model
+func removeStale(dir string, files map[string][]byte) error {
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || filepath.Ext(p) != ".json" {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if _, ok := files[filepath.ToSlash(rel)]; !ok {
+			return os.Remove(p)
+		}
+		return nil
+	})
+}
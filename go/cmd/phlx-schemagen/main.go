@@ -0,0 +1,190 @@
+// Command phlx-schemagen writes Draft 2020-12 JSON Schema files for every model and
+// event payload in the contracts, so Python, TypeScript and Langflow consumers can
+// check themselves against the Go structs.
+//
+// Usage (from the go/ directory):
+//
+//	go run ./cmd/phlx-schemagen -out ../schemas
+//	go run ./cmd/phlx-schemagen -out ../schemas -check
+//
+// Output is deterministic: running the generator twice produces identical files.
+// With -check nothing is written and the command exits non-zero if the files on
+// disk are missing, stale or unexpected.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"github.com/ai-project-787/phlx-contracts/go/internal/catalog"
+)
+
+// schemaIDBase prefixes the $id of every generated schema
+const schemaIDBase = "https://github.com/ai-project-787/phlx-contracts/schemas/"
+
+// index is written to index.json and maps event types and models to their schema files
+type index struct {
+	Events map[string]string `json:"events"`
+	Models map[string]string `json:"models"`
+}
+
+func main() {
+	out := flag.String("out", "schemas", "output directory")
+	check := flag.Bool("check", false, "verify the output directory is up to date instead of writing")
+	flag.Parse()
+
+	files, err := generate()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "phlx-schemagen:", err)
+		os.Exit(1)
+	}
+
+	if *check {
+		problems, err := compare(*out, files)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "phlx-schemagen:", err)
+			os.Exit(1)
+		}
+		for _, p := range problems {
+			fmt.Fprintln(os.Stderr, p)
+		}
+		if len(problems) > 0 {
+			fmt.Fprintln(os.Stderr, "phlx-schemagen: schemas are out of date, run go run ./cmd/phlx-schemagen")
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := write(*out, files); err != nil {
+		fmt.Fprintln(os.Stderr, "phlx-schemagen:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("phlx-schemagen: wrote %d files to %s\n", len(files), *out)
+}
+
+// generate renders every schema file, keyed by slash-separated path relative to the output directory
+func generate() (map[string][]byte, error) {
+	enums := catalog.Enums()
+	files := map[string][]byte{}
+	idx := index{Events: map[string]string{}, Models: map[string]string{}}
+
+	emit := func(rel string, t reflect.Type, overrides map[string]*Schema) error {
+		s := newBuilder(enums).document(t, schemaIDBase+rel, overrides)
+		data, err := encode(s)
+		if err != nil {
+			return fmt.Errorf("%s: %w", t, err)
+		}
+		files[rel] = data
+		return nil
+	}
+
+	for _, t := range catalog.Models() {
+		rel := path.Join("models", t.Name()+".schema.json")
+		if err := emit(rel, t, nil); err != nil {
+			return nil, err
+		}
+		idx.Models[t.Name()] = rel
+	}
+
+	for _, p := range catalog.EventPayloads() {
+		rel := path.Join("events", p.Type.Name()+".schema.json")
+		eventTypes := make([]any, len(p.EventTypes))
+		for i, et := range p.EventTypes {
+			eventTypes[i] = et
+			idx.Events[string(et)] = rel
+		}
+		// Narrow "type" to the event types registered for this payload
+		narrow := map[string]*Schema{"type": {Type: "string", Enum: eventTypes}}
+		if err := emit(rel, p.Type, narrow); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, t := range catalog.EventMessages() {
+		if err := emit(path.Join("events", t.Name()+".schema.json"), t, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := encode(idx)
+	if err != nil {
+		return nil, err
+	}
+	files["index.json"] = data
+	return files, nil
+}
+
+func encode(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func write(dir string, files map[string][]byte) error {
+	for rel, data := range files {
+		target := filepath.Join(dir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compare reports generated files that differ from disk, and schema files on disk
+// that the generator no longer produces
+func compare(dir string, files map[string][]byte) ([]string, error) {
+	var problems []string
+	for rel, want := range files {
+		got, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(rel)))
+		switch {
+		case os.IsNotExist(err):
+			problems = append(problems, "missing: "+rel)
+		case err != nil:
+			return nil, err
+		case !bytes.Equal(got, want):
+			problems = append(problems, "stale: "+rel)
+		}
+	}
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || filepath.Ext(p) != ".json" {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if _, ok := files[filepath.ToSlash(rel)]; !ok {
+			problems = append(problems, "unexpected: "+filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(problems)
+	return problems, nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ai-project-787/phlx-contracts/go/internal/catalog"
+)
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	first, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != len(second) {
+		t.Fatalf("%d files, then %d", len(first), len(second))
+	}
+	for rel, data := range first {
+		if !bytes.Equal(data, second[rel]) {
+			t.Errorf("%s differs between runs", rel)
+		}
+	}
+}
+
+func TestGenerateIndex(t *testing.T) {
+	files, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var idx index
+	if err := json.Unmarshal(files["index.json"], &idx); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range catalog.EventPayloads() {
+		for _, et := range p.EventTypes {
+			rel, ok := idx.Events[string(et)]
+			if !ok {
+				t.Errorf("%s is missing from the index", et)
+				continue
+			}
+			var s Schema
+			if err := json.Unmarshal(files[rel], &s); err != nil {
+				t.Fatalf("%s: %v", rel, err)
+			}
+			// The type property only accepts the event types of this payload
+			var want []any
+			for _, et := range p.EventTypes {
+				want = append(want, string(et))
+			}
+			if !reflect.DeepEqual(s.Properties["type"].Enum, want) {
+				t.Errorf("%s: type enum %v, want %v", rel, s.Properties["type"].Enum, p.EventTypes)
+			}
+		}
+	}
+	for _, typ := range catalog.Models() {
+		if _, ok := files[idx.Models[typ.Name()]]; !ok {
+			t.Errorf("model %s has no schema file", typ.Name())
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	files := map[string][]byte{"index.json": []byte("{}\n"), "events/A.schema.json": []byte("{\"a\":1}\n")}
+	for _, tt := range []struct {
+		name  string
+		setup func(dir string)
+		want  []string
+	}{
+		{"up to date", func(string) {}, nil},
+		{"missing", func(dir string) { os.Remove(filepath.Join(dir, "events", "A.schema.json")) }, []string{"missing: events/A.schema.json"}},
+		{"stale", func(dir string) { os.WriteFile(filepath.Join(dir, "index.json"), []byte("{}"), 0o644) }, []string{"stale: index.json"}},
+		{"unexpected", func(dir string) {
+			os.WriteFile(filepath.Join(dir, "events", "B.schema.json"), []byte("{}"), 0o644)
+			os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a schema"), 0o644)
+		}, []string{"unexpected: events/B.schema.json"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := write(dir, files); err != nil {
+				t.Fatal(err)
+			}
+			tt.setup(dir)
+			got, err := compare(dir, files)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("problems %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	got, err := compare(filepath.Join(t.TempDir(), "absent"), files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"missing: events/A.schema.json", "missing: index.json"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("absent directory: %v, want %v", got, want)
+	}
+}
+
+// TestCommittedSchemasAreCurrent mirrors the -check run in CI
+func TestCommittedSchemasAreCurrent(t *testing.T) {
+	files, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	problems, err := compare(filepath.Join("..", "..", "..", "schemas"), files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range problems {
+		t.Error(p, "(run go run ./cmd/phlx-schemagen -out ../schemas)")
+	}
+}
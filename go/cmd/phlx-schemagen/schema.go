@@ -0,0 +1,274 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// draft202012 is the JSON Schema dialect written by phlx-schemagen
+const draft202012 = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is the subset of JSON Schema 2020-12 used for the contracts
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	ID                   string             `json:"$id,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 any                `json:"type,omitempty"` // string or []string
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+	Sensitivity          string             `json:"x-phlx-sensitivity,omitempty"` // models.Sensitivity of a property
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	objectIDType = reflect.TypeOf(primitive.ObjectID{})
+	bytesType    = reflect.TypeOf([]byte(nil))
+)
+
+// builder produces one self-contained schema document; named structs and enums
+// it meets along the way are collected into $defs
+type builder struct {
+	enums map[reflect.Type][]any
+	defs  map[string]*Schema
+	names map[reflect.Type]string
+}
+
+func newBuilder(enums map[reflect.Type][]any) *builder {
+	return &builder{
+		enums: enums,
+		defs:  map[string]*Schema{},
+		names: map[reflect.Type]string{},
+	}
+}
+
+// document builds the root schema for t; overrides replace the schema of
+// top-level properties before their types are visited
+func (b *builder) document(t reflect.Type, id string, overrides map[string]*Schema) *Schema {
+	root := b.structSchemaWith(t, overrides)
+	root.Schema = draft202012
+	root.ID = id
+	root.Title = t.Name()
+	// The root type is the document itself; drop a self-reference def if recursion created one
+	delete(b.defs, b.names[t])
+	if len(b.defs) > 0 {
+		root.Defs = b.defs
+	}
+	return root
+}
+
+// defName returns a unique $defs key for a named type, qualifying it with the
+// package name when two packages declare the same type name
+func (b *builder) defName(t reflect.Type) string {
+	if name, ok := b.names[t]; ok {
+		return name
+	}
+	name := t.Name()
+	for other, used := range b.names {
+		if used == name && other != t {
+			name = pkgName(t) + "." + t.Name()
+			break
+		}
+	}
+	b.names[t] = name
+	return name
+}
+
+func pkgName(t reflect.Type) string {
+	path := t.PkgPath()
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+func (b *builder) ref(t reflect.Type, build func() *Schema) *Schema {
+	name := b.defName(t)
+	if _, ok := b.defs[name]; !ok {
+		b.defs[name] = &Schema{} // placeholder for recursive types
+		b.defs[name] = build()
+	}
+	return &Schema{Ref: "#/$defs/" + name}
+}
+
+// typeSchema maps a Go type to the schema of its encoding/json representation
+func (b *builder) typeSchema(t reflect.Type) *Schema {
+	if values, ok := b.enums[t]; ok {
+		return b.ref(t, func() *Schema {
+			s := b.kindSchema(t)
+			s.Title = t.Name()
+			s.Enum = values
+			return s
+		})
+	}
+
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case objectIDType:
+		return &Schema{Type: "string", Pattern: "^[0-9a-f]{24}$"}
+	case bytesType:
+		return &Schema{Type: []string{"string", "null"}, ContentEncoding: "base64"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return nullable(b.typeSchema(t.Elem()))
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.structSchema(t)
+		}
+		return b.ref(t, func() *Schema {
+			s := b.structSchema(t)
+			s.Title = t.Name()
+			return s
+		})
+	case reflect.Slice:
+		return nullable(&Schema{Type: "array", Items: b.typeSchema(t.Elem())})
+	case reflect.Array:
+		n := t.Len()
+		return &Schema{Type: "array", Items: b.typeSchema(t.Elem()), MinItems: &n, MaxItems: &n}
+	case reflect.Map:
+		return nullable(&Schema{Type: "object", AdditionalProperties: b.typeSchema(t.Elem())})
+	case reflect.Interface:
+		return &Schema{}
+	default:
+		return b.kindSchema(t)
+	}
+}
+
+// kindSchema maps scalar kinds
+func (b *builder) kindSchema(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		zero := 0.0
+		return &Schema{Type: "integer", Minimum: &zero}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	default:
+		panic(fmt.Sprintf("phlx-schemagen: unsupported kind %s for %s", t.Kind(), t))
+	}
+}
+
+// nullable widens a schema to also accept null, matching nil pointers, slices and maps
+func nullable(s *Schema) *Schema {
+	switch typ := s.Type.(type) {
+	case string:
+		if s.Ref == "" && s.Enum == nil {
+			s.Type = []string{typ, "null"}
+			return s
+		}
+	case nil:
+		if s.Ref == "" && s.AnyOf == nil && s.Enum == nil {
+			return s // {} already accepts null
+		}
+	}
+	return &Schema{AnyOf: []*Schema{s, {Type: "null"}}}
+}
+
+// field is one JSON property of a struct after embedding has been resolved
+type field struct {
+	name        string
+	omitEmpty   bool
+	typ         reflect.Type
+	sensitivity models.Sensitivity
+}
+
+// jsonFields lists the JSON properties of a struct the way encoding/json resolves them:
+// fields of embedded structs are promoted unless a shallower field has the same name
+func jsonFields(t reflect.Type) []field {
+	var direct []field
+	var embedded []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && name == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, sf)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		direct = append(direct, field{
+			name:        name,
+			omitEmpty:   strings.Contains(","+opts+",", ",omitempty,"),
+			typ:         sf.Type,
+			sensitivity: models.SensitivityOf(sf),
+		})
+	}
+
+	seen := map[string]bool{}
+	for _, f := range direct {
+		seen[f.name] = true
+	}
+	fields := direct
+	for _, sf := range embedded {
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		for _, f := range jsonFields(ft) {
+			if seen[f.name] {
+				continue
+			}
+			seen[f.name] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// structSchema builds an object schema; fields without omitempty are always
+// written by encoding/json and are therefore required
+func (b *builder) structSchema(t reflect.Type) *Schema {
+	return b.structSchemaWith(t, nil)
+}
+
+func (b *builder) structSchemaWith(t reflect.Type, overrides map[string]*Schema) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, f := range jsonFields(t) {
+		if o, ok := overrides[f.name]; ok {
+			s.Properties[f.name] = o
+		} else {
+			s.Properties[f.name] = b.typeSchema(f.typ)
+		}
+		s.Properties[f.name].Sensitivity = string(f.sensitivity)
+		if !f.omitEmpty {
+			s.Required = append(s.Required, f.name)
+		}
+	}
+	return s
+}
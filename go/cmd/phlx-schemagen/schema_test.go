@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+type level string
+
+type node struct {
+	Name     string `json:"name"`
+	Children []node `json:"children,omitempty"`
+}
+
+type inner struct {
+	ID    string `json:"id"`
+	Shade string `json:"shade"`
+	Note  string `json:"note,omitempty"`
+}
+
+type outer struct {
+	inner
+	Shade   int    `json:"shade"`
+	Skipped string `json:"-"`
+	hidden  string
+	Raw     string
+	Secret  string `json:"secret,omitempty" sensitivity:"pii"`
+}
+
+// Alert shares its name with models.Alert
+type Alert struct {
+	Own string `json:"own"`
+}
+
+type alerts struct {
+	Local  Alert        `json:"local"`
+	Source models.Alert `json:"source"`
+}
+
+func schemaJSON(t *testing.T, s *Schema) string {
+	t.Helper()
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestTypeSchema(t *testing.T) {
+	enums := map[reflect.Type][]any{reflect.TypeOf(level("")): {"low", "high"}}
+	for _, tt := range []struct {
+		name string
+		v    any
+		want string
+	}{
+		{"string", "", `{"type":"string"}`},
+		{"bool", false, `{"type":"boolean"}`},
+		{"int", int64(0), `{"type":"integer"}`},
+		{"uint", uint8(0), `{"type":"integer","minimum":0}`},
+		{"float", 0.0, `{"type":"number"}`},
+		{"time", time.Time{}, `{"type":"string","format":"date-time"}`},
+		{"object ID", primitive.ObjectID{}, `{"type":"string","pattern":"^[0-9a-f]{24}$"}`},
+		{"bytes", []byte(nil), `{"type":["string","null"],"contentEncoding":"base64"}`},
+		{"pointer", (*string)(nil), `{"type":["string","null"]}`},
+		{"slice", []int(nil), `{"type":["array","null"],"items":{"type":"integer"}}`},
+		{"array", [2]float64{}, `{"type":"array","items":{"type":"number"},"minItems":2,"maxItems":2}`},
+		{"map", map[string]bool(nil), `{"type":["object","null"],"additionalProperties":{"type":"boolean"}}`},
+		{"interface", new(any), `{}`},
+		{"enum", level(""), `{"$ref":"#/$defs/level"}`},
+		{"enum pointer", (*level)(nil), `{"anyOf":[{"$ref":"#/$defs/level"},{"type":"null"}]}`},
+		{"struct", inner{}, `{"$ref":"#/$defs/inner"}`},
+		{"anonymous struct", struct {
+			A string `json:"a"`
+		}{}, `{"type":"object","properties":{"a":{"type":"string"}},"required":["a"]}`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			typ := reflect.TypeOf(tt.v)
+			if tt.name == "interface" {
+				typ = typ.Elem()
+			}
+			if got := schemaJSON(t, newBuilder(enums).typeSchema(typ)); got != tt.want {
+				t.Errorf("schema %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONFields(t *testing.T) {
+	var got []string
+	for _, f := range jsonFields(reflect.TypeOf(outer{})) {
+		got = append(got, f.name+":"+f.typ.String()+":"+string(f.sensitivity))
+		if f.name == "note" && !f.omitEmpty {
+			t.Error("promoted omitempty field is required")
+		}
+	}
+	// The shallower shade shadows the embedded one; json:"-" and unexported fields are skipped
+	want := []string{"shade:int:", "Raw:string:", "secret:string:pii", "id:string:", "note:string:"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fields %v, want %v", got, want)
+	}
+
+	s := newBuilder(nil).structSchema(reflect.TypeOf(outer{}))
+	if want := []string{"shade", "Raw", "id"}; !reflect.DeepEqual(s.Required, want) {
+		t.Errorf("required %v, want %v", s.Required, want)
+	}
+	if s.Properties["secret"].Sensitivity != "pii" {
+		t.Errorf("secret sensitivity %q, want pii", s.Properties["secret"].Sensitivity)
+	}
+}
+
+func TestDocument(t *testing.T) {
+	root := newBuilder(nil).document(reflect.TypeOf(node{}), "https://example.test/node.json", map[string]*Schema{"name": {Type: "string", Enum: []any{"root"}}})
+	got := schemaJSON(t, root)
+	for _, want := range []string{
+		`"$schema":"` + draft202012 + `"`,
+		`"$id":"https://example.test/node.json"`,
+		`"title":"node"`,
+		`"name":{"type":"string","enum":["root"]}`,
+		`"children":{"type":["array","null"],"items":{"$ref":"#/$defs/node"}}`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("document %s does not contain %s", got, want)
+		}
+	}
+	// The recursive reference points at the root, so no def is written for it
+	if root.Defs != nil {
+		t.Errorf("defs %v, want none", root.Defs)
+	}
+}
+
+func TestDefNameCollision(t *testing.T) {
+	root := newBuilder(nil).document(reflect.TypeOf(alerts{}), "", nil)
+	if got := root.Properties["local"].Ref; got != "#/$defs/Alert" {
+		t.Errorf("local ref %q, want Alert", got)
+	}
+	if got := root.Properties["source"].Ref; got != "#/$defs/models.Alert" {
+		t.Errorf("source ref %q, want the package-qualified models.Alert", got)
+	}
+	if root.Defs["Alert"].Properties["own"] == nil || root.Defs["models.Alert"].Properties["location_id"] == nil {
+		t.Errorf("defs %v mix up the two Alert types", root.Defs)
+	}
+}
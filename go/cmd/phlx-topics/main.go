@@ -0,0 +1,54 @@
+// Command phlx-topics writes the Kafka topic-creation manifest: every topic in
+// events.KafkaTopics with its retry and dead-letter topics, as topics.json and
+// topics.yaml. See internal/topicmanifest.
+//
+// Usage (from the go/ directory):
+//
+//	go run ./cmd/phlx-topics
+//	go run ./cmd/phlx-topics -out ../deploy/topics -tenants agency-a,agency-b
+//
+// -tenants adds each tenant's copy of every topic, for environments that publish with
+// events.TenantTopics. The committed manifest in topics/ lists the shared topics only.
+//
+// Output is deterministic. go test ./internal/topicmanifest fails when the committed
+// manifest is stale, so regenerate it after changing a TopicSpec or a RetryPolicy.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ai-project-787/phlx-contracts/go/internal/topicmanifest"
+)
+
+func main() {
+	out := flag.String("out", "../topics", "output directory")
+	tenants := flag.String("tenants", "", "comma-separated tenant IDs to add tenant topics for")
+	flag.Parse()
+
+	var tenantIDs []string
+	if *tenants != "" {
+		tenantIDs = strings.Split(*tenants, ",")
+	}
+	files, err := topicmanifest.Files(tenantIDs...)
+	if err != nil {
+		fail(err)
+	}
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		fail(err)
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(*out, name), data, 0o644); err != nil {
+			fail(err)
+		}
+	}
+	fmt.Printf("phlx-topics: wrote %d files to %s\n", len(files), *out)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "phlx-topics:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,46 @@
+package events
+
+import (
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// NewAssetRecallEventData builds the recall event for a recall order so producers
+// and consumers share the same field mapping
+func NewAssetRecallEventData(base BaseEvent, asset *models.Asset, recall models.AssetRecall) *AssetRecallEventData {
+	base.Type = AssetRecallEvent
+	e := &AssetRecallEventData{
+		BaseEvent:      base,
+		AssetID:        recall.AssetID,
+		MissionID:      recall.MissionID,
+		Reason:         string(recall.Reason),
+		Notes:          recall.Notes,
+		ReturnBaseID:   recall.ReturnBaseID,
+		ReturnBaseName: recall.ReturnBaseName,
+		ReturnBy:       recall.ReturnBy,
+		RecalledBy:     recall.RecalledBy,
+		RecalledByName: recall.RecalledByName,
+	}
+	if asset != nil {
+		e.AssetName = asset.Name
+		if asset.Latitude != 0 || asset.Longitude != 0 {
+			e.Location = &LocationData{Latitude: asset.Latitude, Longitude: asset.Longitude, Altitude: asset.Altitude}
+		}
+	}
+	return e
+}
+
+// AssetRecall converts the event back into the recall order it describes
+func (e *AssetRecallEventData) AssetRecall() models.AssetRecall {
+	return models.AssetRecall{
+		AssetID:        e.AssetID,
+		MissionID:      e.MissionID,
+		Reason:         models.AssetRecallReason(e.Reason),
+		Notes:          e.Notes,
+		ReturnBaseID:   e.ReturnBaseID,
+		ReturnBaseName: e.ReturnBaseName,
+		ReturnBy:       e.ReturnBy,
+		RecalledBy:     e.RecalledBy,
+		RecalledByName: e.RecalledByName,
+		IssuedAt:       e.Timestamp,
+	}
+}
@@ -0,0 +1,53 @@
+package events
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// TestAssetRecallRulesMatchModel checks the event rejects exactly the fields the recall
+// order it carries is rejected for
+func TestAssetRecallRulesMatchModel(t *testing.T) {
+	issued := time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name   string
+		mutate func(r *models.AssetRecall)
+		want   []string
+	}{
+		{"valid", func(r *models.AssetRecall) {}, nil},
+		{"operator order with notes", func(r *models.AssetRecall) { r.Reason, r.Notes = models.RecallReasonOperatorOrder, "Crew rotation" }, nil},
+		{"operator order without notes", func(r *models.AssetRecall) { r.Reason = models.RecallReasonOperatorOrder }, []string{"notes"}},
+		{"operator order with blank notes", func(r *models.AssetRecall) { r.Reason, r.Notes = models.RecallReasonOperatorOrder, "  " }, []string{"notes"}},
+		{"unknown reason", func(r *models.AssetRecall) { r.Reason = "bored" }, []string{"reason"}},
+		{"deadline before issue", func(r *models.AssetRecall) { r.ReturnBy = issued.Add(-time.Minute) }, []string{"returnBy"}},
+		{"every field", func(r *models.AssetRecall) { *r = models.AssetRecall{IssuedAt: issued} }, []string{"assetId", "reason", "returnBaseId", "returnBy", "recalledBy"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			recall := models.AssetRecall{
+				AssetID: "asset-1", Reason: models.RecallReasonWeather, ReturnBaseID: "base-1",
+				ReturnBy: issued.Add(time.Hour), RecalledBy: "op-1", IssuedAt: issued,
+			}
+			tt.mutate(&recall)
+			e := NewAssetRecallEventData(BaseEvent{ID: "evt-1", Source: "test", Timestamp: issued}, nil, recall)
+
+			for name, err := range map[string]error{"event": e.Validate(), "model": recall.Validate()} {
+				var got []string
+				var verrs ValidationErrors
+				if errors.As(err, &verrs) {
+					for _, fe := range verrs {
+						got = append(got, fe.Field)
+					}
+				} else if err != nil {
+					t.Fatalf("%s: %v is not a ValidationErrors", name, err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("%s: invalid fields %v, want %v", name, got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Claim-check offloading of oversized payloads.
+//
+// A few payloads, such as event_analysis with its rawResponse and free-form metadata,
+// can outgrow the broker's message size limit. After encoding, producers pass the record
+// to a ClaimCheck, which moves the largest top-level payload fields to a BlobStore until
+// the record fits, replacing each with a content-addressed reference:
+//
+//	"rawResponse": {"$claim": "sha256:9f86d0…", "size": 1843200}
+//
+// and listing the moved fields in the phlx-claims header. Consumers rehydrate the record
+// before decoding it:
+//
+//	cc := events.NewClaimCheck(fsblob.New("/var/lib/phlx/claims"), 0)
+//	if err := cc.Offload(ctx, msg); err != nil { ... } // producer, after EncodeMessage
+//	if err := cc.Rehydrate(ctx, msg); err != nil { ... } // consumer, before DecodeMessage
+//
+// Only JSON values are offloaded; Protobuf records are left as they are. BaseEvent
+// fields always stay in the record so it can be routed, traced and deduplicated.
+
+const (
+	// ClaimsHeader lists the payload fields a record's value references, comma-separated
+	ClaimsHeader = "phlx-claims"
+	// DefaultClaimCheckThreshold keeps records under Kafka's default 1 MiB
+	// max.message.bytes, with room for headers
+	DefaultClaimCheckThreshold = 900 * 1024
+	// minClaimSize is the smallest field worth replacing with a reference
+	minClaimSize = 1024
+	// claimKeyPrefix prefixes the SHA-256 of the content in a blob key
+	claimKeyPrefix = "sha256:"
+)
+
+var (
+	// ErrBlobNotFound is returned by BlobStore.Get for an unknown key
+	ErrBlobNotFound = errors.New("events: blob not found")
+	// ErrClaimCorrupt is returned when a blob does not match the digest it is stored under
+	ErrClaimCorrupt = errors.New("events: claimed blob does not match its digest")
+	// ErrPayloadTooLarge is returned by Offload when the record is still over the
+	// threshold after every eligible field was moved
+	ErrPayloadTooLarge = errors.New("events: payload too large")
+	// ErrClaimNotRehydrated is returned when a record with claim references is decoded
+	// before ClaimCheck.Rehydrate
+	ErrClaimNotRehydrated = errors.New("events: record has claim references; rehydrate it before decoding")
+)
+
+// BlobStore holds offloaded payload fields. Keys are content addresses, so Put of an
+// existing key may skip the write.
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns ErrBlobNotFound for an unknown key
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// ClaimReference replaces an offloaded field in the record value
+type ClaimReference struct {
+	Claim string `json:"$claim"` // BlobKey of the field's JSON value
+	Size  int    `json:"size"`
+}
+
+// BlobKey returns the content address of data, "sha256:<hex digest>"
+func BlobKey(data []byte) string {
+	sum := sha256.Sum256(data)
+	return claimKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+// IsValidBlobKey checks if a key is a content address returned by BlobKey
+func IsValidBlobKey(key string) bool {
+	digest, ok := strings.CutPrefix(key, claimKeyPrefix)
+	if !ok || len(digest) != 2*sha256.Size {
+		return false
+	}
+	_, err := hex.DecodeString(digest)
+	return err == nil && strings.ToLower(digest) == digest
+}
+
+// ClaimCheck offloads oversized record values to a BlobStore and rehydrates them
+type ClaimCheck struct {
+	store     BlobStore
+	threshold int
+}
+
+// NewClaimCheck returns a ClaimCheck that offloads records larger than threshold bytes;
+// threshold <= 0 uses DefaultClaimCheckThreshold
+func NewClaimCheck(store BlobStore, threshold int) *ClaimCheck {
+	if threshold <= 0 {
+		threshold = DefaultClaimCheckThreshold
+	}
+	return &ClaimCheck{store: store, threshold: threshold}
+}
+
+// Offload moves the largest payload fields of a record over the threshold to the store,
+// largest first, until the record fits. Records under the threshold and non-JSON records
+// are left unchanged.
+func (c *ClaimCheck) Offload(ctx context.Context, msg *KafkaMessage) error {
+	size := recordSize(msg)
+	if size <= c.threshold || !isJSONRecord(msg) {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg.Value, &fields); err != nil {
+		return fmt.Errorf("events: claim check: value must be a JSON object: %w", err)
+	}
+
+	candidates := make([]string, 0, len(fields))
+	for name, raw := range fields {
+		if !baseEventKeys[name] && len(raw) >= minClaimSize {
+			candidates = append(candidates, name)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := len(fields[candidates[i]]), len(fields[candidates[j]])
+		if a != b {
+			return a > b
+		}
+		return candidates[i] < candidates[j]
+	})
+
+	claimed, _ := msg.Header(ClaimsHeader)
+	names := splitClaims(claimed)
+	for _, name := range candidates {
+		if size <= c.threshold {
+			break
+		}
+		raw := fields[name]
+		key := BlobKey(raw)
+		if err := c.store.Put(ctx, key, raw); err != nil {
+			return fmt.Errorf("events: claim check %s: %w", name, err)
+		}
+		ref, _ := json.Marshal(ClaimReference{Claim: key, Size: len(raw)})
+		fields[name] = ref
+		names = append(names, name)
+		size -= len(raw) - len(ref)
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("%w: %d bytes and no field to offload", ErrPayloadTooLarge, size)
+	}
+
+	value, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	msg.Value = value
+	sort.Strings(names)
+	msg.SetHeader(ClaimsHeader, strings.Join(names, ","))
+	if size := recordSize(msg); size > c.threshold {
+		return fmt.Errorf("%w: %d bytes after offloading %s", ErrPayloadTooLarge, size, strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// Rehydrate replaces the references in a record value with the fields they claim and
+// removes the claims header. Records without the header are left unchanged.
+func (c *ClaimCheck) Rehydrate(ctx context.Context, msg *KafkaMessage) error {
+	claimed, ok := msg.Header(ClaimsHeader)
+	if !ok {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg.Value, &fields); err != nil {
+		return fmt.Errorf("events: rehydrate: value must be a JSON object: %w", err)
+	}
+	for _, name := range splitClaims(claimed) {
+		var ref ClaimReference
+		if err := json.Unmarshal(fields[name], &ref); err != nil || !IsValidBlobKey(ref.Claim) {
+			return fmt.Errorf("events: rehydrate %s: not a claim reference", name)
+		}
+		data, err := c.store.Get(ctx, ref.Claim)
+		if err != nil {
+			return fmt.Errorf("events: rehydrate %s: %w", name, err)
+		}
+		if BlobKey(data) != ref.Claim {
+			return fmt.Errorf("%w: %s", ErrClaimCorrupt, ref.Claim)
+		}
+		fields[name] = data
+	}
+
+	value, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	msg.Value = value
+	msg.Headers = removeHeader(msg.Headers, ClaimsHeader)
+	return nil
+}
+
+// checkRehydrated returns ErrClaimNotRehydrated if a record still carries the claims header
+func checkRehydrated(msg *KafkaMessage) error {
+	if claimed, ok := msg.Header(ClaimsHeader); ok {
+		return fmt.Errorf("%w: %s", ErrClaimNotRehydrated, claimed)
+	}
+	return nil
+}
+
+// baseEventKeys are the JSON keys of BaseEvent, which are never offloaded
+var baseEventKeys = func() map[string]bool {
+	keys := map[string]bool{}
+	t := reflect.TypeOf(BaseEvent{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		keys[name] = true
+	}
+	return keys
+}()
+
+// recordSize approximates the size the broker checks: key, value and headers
+func recordSize(msg *KafkaMessage) int {
+	size := len(msg.Key) + len(msg.Value)
+	for _, h := range msg.Headers {
+		size += len(h.Key) + len(h.Value)
+	}
+	return size
+}
+
+// isJSONRecord reports whether a record's value is JSON: its content type is JSON or unset
+func isJSONRecord(msg *KafkaMessage) bool {
+	ct, ok := msg.Header(contentTypeHeader)
+	return !ok || strings.HasPrefix(ct, ContentTypeJSON)
+}
+
+func splitClaims(header string) []string {
+	if header == "" {
+		return nil
+	}
+	return strings.Split(header, ",")
+}
+
+func removeHeader(headers []KafkaHeader, key string) []KafkaHeader {
+	out := headers[:0]
+	for _, h := range headers {
+		if h.Key != key {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+// MemoryBlobStore is a BlobStore for tests and single-process tools
+type MemoryBlobStore struct {
+	mu    sync.RWMutex
+	blobs map[string][]byte
+}
+
+// NewMemoryBlobStore returns an empty store
+func NewMemoryBlobStore() *MemoryBlobStore {
+	return &MemoryBlobStore{blobs: map[string][]byte{}}
+}
+
+// Put implements BlobStore
+func (s *MemoryBlobStore) Put(_ context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blobs[key] = bytes.Clone(data)
+	return nil
+}
+
+// Get implements BlobStore
+func (s *MemoryBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.blobs[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrBlobNotFound, key)
+	}
+	return bytes.Clone(data), nil
+}
+
+// Len returns the number of blobs held
+func (s *MemoryBlobStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.blobs)
+}
@@ -0,0 +1,132 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// oversizedAnalysis returns an event_analysis record of about 6 KiB
+func oversizedAnalysis(t *testing.T) (*EventAnalysisEventData, *KafkaMessage) {
+	e := samplePayload(t, EventAnalysisEvent).(*EventAnalysisEventData)
+	e.RawResponse = strings.Repeat("model output ", 400)
+	e.Metadata = map[string]interface{}{"frames": strings.Repeat("f", 1200)}
+	msg, err := EncodeMessage(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e, msg
+}
+
+func TestClaimCheckRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryBlobStore()
+	cc := NewClaimCheck(store, 4096)
+	e, msg := oversizedAnalysis(t)
+
+	if err := cc.Offload(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+	if claims, _ := msg.Header(ClaimsHeader); claims != "rawResponse" {
+		t.Errorf("claims header %q", claims)
+	}
+	if size := recordSize(msg); size > 4096 {
+		t.Errorf("offloaded record is %d bytes", size)
+	}
+	if !strings.Contains(string(msg.Value), `"rawResponse":{"$claim":"sha256:`) || store.Len() != 1 {
+		t.Errorf("offloaded value %s", msg.Value)
+	}
+
+	if _, err := DecodeMessage(msg); !errors.Is(err, ErrClaimNotRehydrated) {
+		t.Errorf("DecodeMessage before Rehydrate: %v", err)
+	}
+	if _, err := DecodeCloudEventBinary(msg); !errors.Is(err, ErrClaimNotRehydrated) {
+		t.Errorf("DecodeCloudEventBinary before Rehydrate: %v", err)
+	}
+
+	if err := cc.Rehydrate(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := msg.Header(ClaimsHeader); ok {
+		t.Error("claims header kept after rehydrating")
+	}
+	decoded, err := DecodeMessage(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := decoded.(*EventAnalysisEventData)
+	if got.RawResponse != e.RawResponse || got.Metadata["frames"] != e.Metadata["frames"] || got.ID != e.ID {
+		t.Errorf("rehydrated payload differs: %+v", got)
+	}
+}
+
+func TestClaimCheckOffloadsLargestFirst(t *testing.T) {
+	ctx := context.Background()
+	cc := NewClaimCheck(NewMemoryBlobStore(), 1500)
+	_, msg := oversizedAnalysis(t)
+	if err := cc.Offload(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+	if claims, _ := msg.Header(ClaimsHeader); claims != "metadata,rawResponse" {
+		t.Errorf("claims header %q", claims)
+	}
+
+	tiny := NewClaimCheck(NewMemoryBlobStore(), 100)
+	_, msg = oversizedAnalysis(t)
+	if err := tiny.Offload(ctx, msg); !errors.Is(err, ErrPayloadTooLarge) {
+		t.Errorf("record that cannot fit: %v", err)
+	}
+}
+
+func TestClaimCheckLeavesRecordsAlone(t *testing.T) {
+	ctx := context.Background()
+	cc := NewClaimCheck(NewMemoryBlobStore(), 0)
+	_, msg := oversizedAnalysis(t)
+	before := string(msg.Value)
+	if err := cc.Offload(ctx, msg); err != nil || string(msg.Value) != before {
+		t.Errorf("record under the default threshold changed: %v", err)
+	}
+
+	small := NewClaimCheck(NewMemoryBlobStore(), 1024)
+	_, msg = oversizedAnalysis(t)
+	msg.SetHeader(contentTypeHeader, ContentTypeProtobuf)
+	before = string(msg.Value)
+	if err := small.Offload(ctx, msg); err != nil || string(msg.Value) != before {
+		t.Errorf("Protobuf record changed: %v", err)
+	}
+	if err := small.Rehydrate(ctx, msg); err != nil {
+		t.Errorf("record without claims: %v", err)
+	}
+}
+
+func TestRehydrateErrors(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryBlobStore()
+	_, msg := oversizedAnalysis(t)
+	if err := NewClaimCheck(store, 4096).Offload(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewClaimCheck(NewMemoryBlobStore(), 4096).Rehydrate(ctx, msg); !errors.Is(err, ErrBlobNotFound) {
+		t.Errorf("missing blob: %v", err)
+	}
+	for key := range store.blobs {
+		store.blobs[key] = []byte(`"tampered"`)
+	}
+	if err := NewClaimCheck(store, 4096).Rehydrate(ctx, msg); !errors.Is(err, ErrClaimCorrupt) {
+		t.Errorf("tampered blob: %v", err)
+	}
+}
+
+func TestBlobKey(t *testing.T) {
+	key := BlobKey([]byte("abc"))
+	if key != "sha256:ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad" || !IsValidBlobKey(key) {
+		t.Errorf("BlobKey() = %q", key)
+	}
+	for _, bad := range []string{"", "sha256:abc", "md5:ba7816bf8f01cfea414140de5dae2223", "sha256:../../../../etc/passwd", strings.ToUpper(key)} {
+		if IsValidBlobKey(bad) {
+			t.Errorf("IsValidBlobKey(%q) = true", bad)
+		}
+	}
+}
@@ -0,0 +1,403 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CloudEvents 1.0 binding for BaseEvent payloads.
+//
+// Attribute mapping:
+//   - id            ← BaseEvent.ID
+//   - source        ← BaseEvent.Source
+//   - type          ← BaseEvent.Type
+//   - time          ← BaseEvent.Timestamp
+//   - subject       ← PartitionKeyFor(payload)
+//   - schemaversion ← BaseEvent.SchemaVersion (extension)
+//   - partitionkey  ← PartitionKeyFor(payload) (partitioning extension)
+//   - traceparent   ← BaseEvent.TraceParent (distributed tracing extension)
+//   - tracestate    ← BaseEvent.TraceState (distributed tracing extension)
+//   - tenantid      ← BaseEvent.TenantID (extension)
+//
+// data is the payload JSON exactly as it is published on Kafka today, so
+// consumers that ignore the CloudEvents attributes can still read it.
+
+const (
+	// CloudEventsSpecVersion is the CloudEvents specification implemented here
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsContentType is the content type of structured-mode messages
+	CloudEventsContentType = "application/cloudevents+json"
+	// CloudEventsDataContentType is the content type of event data
+	CloudEventsDataContentType = "application/json"
+
+	// cloudEventsHeaderPrefix prefixes attribute headers in Kafka binary mode
+	cloudEventsHeaderPrefix = "ce_"
+	// contentTypeHeader carries datacontenttype in Kafka binary mode
+	contentTypeHeader = "content-type"
+
+	ceExtSchemaVersion = "schemaversion"
+	ceExtPartitionKey  = "partitionkey"
+	ceExtTraceParent   = "traceparent"
+	ceExtTraceState    = "tracestate"
+	ceExtTenantID      = "tenantid"
+)
+
+// ErrInvalidCloudEvent is returned when a CloudEvent is missing required attributes
+var ErrInvalidCloudEvent = errors.New("events: invalid CloudEvent")
+
+// CloudEvent is a CloudEvents 1.0 event in structured form
+type CloudEvent struct {
+	SpecVersion     string
+	ID              string
+	Source          string
+	Type            string
+	Subject         string
+	Time            time.Time
+	DataContentType string
+	Data            json.RawMessage
+	Extensions      map[string]string
+}
+
+// cloudEventAttributes lists the context attributes defined by the spec
+var cloudEventAttributes = map[string]bool{
+	"specversion": true, "id": true, "source": true, "type": true, "subject": true,
+	"time": true, "datacontenttype": true, "dataschema": true, "data": true, "data_base64": true,
+}
+
+// MarshalJSON writes the event in structured JSON mode with extensions at the top level
+func (ce CloudEvent) MarshalJSON() ([]byte, error) {
+	fields := map[string]any{
+		"specversion": ce.SpecVersion,
+		"id":          ce.ID,
+		"source":      ce.Source,
+		"type":        ce.Type,
+	}
+	if ce.Subject != "" {
+		fields["subject"] = ce.Subject
+	}
+	if !ce.Time.IsZero() {
+		fields["time"] = ce.Time.UTC().Format(time.RFC3339Nano)
+	}
+	if ce.DataContentType != "" {
+		fields["datacontenttype"] = ce.DataContentType
+	}
+	if len(ce.Data) > 0 {
+		fields["data"] = ce.Data
+	}
+	for k, v := range ce.Extensions {
+		fields[k] = v
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON reads a structured JSON mode event
+func (ce *CloudEvent) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	str := func(name string) (string, error) {
+		raw, ok := fields[name]
+		if !ok {
+			return "", nil
+		}
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return "", fmt.Errorf("%w: attribute %s: %v", ErrInvalidCloudEvent, name, err)
+		}
+		return s, nil
+	}
+
+	var out CloudEvent
+	var err error
+	for name, dst := range map[string]*string{
+		"specversion":     &out.SpecVersion,
+		"id":              &out.ID,
+		"source":          &out.Source,
+		"type":            &out.Type,
+		"subject":         &out.Subject,
+		"datacontenttype": &out.DataContentType,
+	} {
+		if *dst, err = str(name); err != nil {
+			return err
+		}
+	}
+	t, err := str("time")
+	if err != nil {
+		return err
+	}
+	if t != "" {
+		if out.Time, err = time.Parse(time.RFC3339Nano, t); err != nil {
+			return fmt.Errorf("%w: attribute time: %v", ErrInvalidCloudEvent, err)
+		}
+	}
+	out.Data = fields["data"]
+
+	for name, raw := range fields {
+		if cloudEventAttributes[name] {
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			// Non-string extension values are kept in their JSON form
+			s = string(raw)
+		}
+		if out.Extensions == nil {
+			out.Extensions = map[string]string{}
+		}
+		out.Extensions[name] = s
+	}
+
+	*ce = out
+	return nil
+}
+
+// validate checks the required context attributes
+func (ce *CloudEvent) validate() error {
+	var missing []string
+	for name, v := range map[string]string{
+		"specversion": ce.SpecVersion, "id": ce.ID, "source": ce.Source, "type": ce.Type,
+	} {
+		if v == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("%w: missing %s", ErrInvalidCloudEvent, strings.Join(missing, ", "))
+	}
+	if ce.SpecVersion != CloudEventsSpecVersion {
+		return fmt.Errorf("%w: unsupported specversion %q", ErrInvalidCloudEvent, ce.SpecVersion)
+	}
+	return nil
+}
+
+// ToCloudEvent converts a payload into a CloudEvent
+func ToCloudEvent(payload Event) (*CloudEvent, error) {
+	base := payload.EventBase()
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("events: encode %q payload: %w", base.Type, err)
+	}
+
+	ce := &CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              base.ID,
+		Source:          base.Source,
+		Type:            string(base.Type),
+		Time:            base.Timestamp,
+		DataContentType: CloudEventsDataContentType,
+		Data:            data,
+	}
+	if key := PartitionKeyFor(payload); key != "" {
+		ce.Subject = key
+		ce.setExtension(ceExtPartitionKey, key)
+	}
+	if base.SchemaVersion != "" {
+		ce.setExtension(ceExtSchemaVersion, base.SchemaVersion)
+	}
+	if base.TraceParent != "" {
+		ce.setExtension(ceExtTraceParent, base.TraceParent)
+	}
+	if base.TraceState != "" {
+		ce.setExtension(ceExtTraceState, base.TraceState)
+	}
+	if base.TenantID != "" {
+		ce.setExtension(ceExtTenantID, base.TenantID)
+	}
+	return ce, ce.validate()
+}
+
+func (ce *CloudEvent) setExtension(name, value string) {
+	if ce.Extensions == nil {
+		ce.Extensions = map[string]string{}
+	}
+	ce.Extensions[name] = value
+}
+
+// FromCloudEvent decodes the CloudEvent data into its registered payload struct.
+// The context attributes take precedence over the matching fields inside data.
+func FromCloudEvent(ce *CloudEvent) (any, error) {
+	if err := ce.validate(); err != nil {
+		return nil, err
+	}
+	if ce.DataContentType != "" && !strings.HasPrefix(ce.DataContentType, CloudEventsDataContentType) {
+		return fromCloudEventCodec(ce)
+	}
+
+	fields := map[string]json.RawMessage{}
+	if len(ce.Data) > 0 && string(ce.Data) != "null" {
+		if err := json.Unmarshal(ce.Data, &fields); err != nil {
+			return nil, fmt.Errorf("%w: data must be a JSON object: %v", ErrInvalidCloudEvent, err)
+		}
+	}
+	set := func(name, value string) {
+		raw, _ := json.Marshal(value)
+		fields[name] = raw
+	}
+	set("id", ce.ID)
+	set("type", ce.Type)
+	set("source", ce.Source)
+	if v, ok := ce.Extensions[ceExtSchemaVersion]; ok {
+		set("schemaVersion", v)
+	}
+	if v, ok := ce.Extensions[ceExtTraceParent]; ok {
+		set("traceparent", v)
+	}
+	if v, ok := ce.Extensions[ceExtTraceState]; ok {
+		set("tracestate", v)
+	}
+	if v, ok := ce.Extensions[ceExtTenantID]; ok {
+		set("tenantId", v)
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	// "timestamp" is shadowed by some payloads, so time is applied to the base directly
+	if e, ok := payload.(Event); ok && !ce.Time.IsZero() {
+		e.EventBase().Timestamp = ce.Time
+	}
+	return payload, nil
+}
+
+// fromCloudEventCodec decodes data in a non-JSON format, such as Protobuf, with its codec
+func fromCloudEventCodec(ce *CloudEvent) (any, error) {
+	codec, err := CodecFor(ce.DataContentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unsupported datacontenttype %q", ErrInvalidCloudEvent, ce.DataContentType)
+	}
+	payload, err := codec.Unmarshal(EventType(ce.Type), ce.Data)
+	if err != nil {
+		return nil, err
+	}
+	base := payload.EventBase()
+	base.ID = ce.ID
+	base.Source = ce.Source
+	if !ce.Time.IsZero() {
+		base.Timestamp = ce.Time
+	}
+	if v, ok := ce.Extensions[ceExtSchemaVersion]; ok {
+		base.SchemaVersion = v
+	}
+	if v, ok := ce.Extensions[ceExtTraceParent]; ok {
+		base.TraceParent = v
+	}
+	if v, ok := ce.Extensions[ceExtTraceState]; ok {
+		base.TraceState = v
+	}
+	if v, ok := ce.Extensions[ceExtTenantID]; ok {
+		base.TenantID = v
+	}
+	return payload, nil
+}
+
+// EncodeCloudEventStructured encodes a payload as a structured-mode CloudEvent
+func EncodeCloudEventStructured(payload Event) ([]byte, error) {
+	ce, err := ToCloudEvent(payload)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(ce)
+}
+
+// DecodeCloudEventStructured decodes a structured-mode CloudEvent into its payload
+func DecodeCloudEventStructured(data []byte) (any, error) {
+	var ce CloudEvent
+	if err := json.Unmarshal(data, &ce); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCloudEvent, err)
+	}
+	return FromCloudEvent(&ce)
+}
+
+// EncodeCloudEventBinary encodes a payload in Kafka binary mode: attributes travel as
+// ce_* headers, the record value is the event data and the record key is the partition key.
+// Events without a topic route return ErrNoTopicRoute.
+func EncodeCloudEventBinary(payload Event) (*KafkaMessage, error) {
+	ce, err := ToCloudEvent(payload)
+	if err != nil {
+		return nil, err
+	}
+	topic, err := TopicForEvent(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &KafkaMessage{Topic: topic, Value: ce.Data}
+	if key, ok := ce.Extensions[ceExtPartitionKey]; ok {
+		msg.Key = []byte(key)
+	}
+	msg.SetHeader(contentTypeHeader, ce.DataContentType)
+	msg.SetHeader(cloudEventsHeaderPrefix+"specversion", ce.SpecVersion)
+	msg.SetHeader(cloudEventsHeaderPrefix+"id", ce.ID)
+	msg.SetHeader(cloudEventsHeaderPrefix+"source", ce.Source)
+	msg.SetHeader(cloudEventsHeaderPrefix+"type", ce.Type)
+	if ce.Subject != "" {
+		msg.SetHeader(cloudEventsHeaderPrefix+"subject", ce.Subject)
+	}
+	if !ce.Time.IsZero() {
+		msg.SetHeader(cloudEventsHeaderPrefix+"time", ce.Time.UTC().Format(time.RFC3339Nano))
+	}
+
+	names := make([]string, 0, len(ce.Extensions))
+	for name := range ce.Extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		msg.SetHeader(cloudEventsHeaderPrefix+name, ce.Extensions[name])
+	}
+	return msg, nil
+}
+
+// DecodeCloudEventBinary decodes a Kafka binary-mode CloudEvent into its payload.
+// Messages whose content-type is application/cloudevents+json are decoded as structured mode.
+func DecodeCloudEventBinary(msg *KafkaMessage) (any, error) {
+	if err := checkRehydrated(msg); err != nil {
+		return nil, err
+	}
+	if ct, ok := msg.Header(contentTypeHeader); ok && strings.HasPrefix(ct, CloudEventsContentType) {
+		return DecodeCloudEventStructured(msg.Value)
+	}
+
+	ce := &CloudEvent{Data: msg.Value}
+	ce.DataContentType, _ = msg.Header(contentTypeHeader)
+	for _, h := range msg.Headers {
+		name, ok := strings.CutPrefix(h.Key, cloudEventsHeaderPrefix)
+		if !ok {
+			continue
+		}
+		value := string(h.Value)
+		switch name {
+		case "specversion":
+			ce.SpecVersion = value
+		case "id":
+			ce.ID = value
+		case "source":
+			ce.Source = value
+		case "type":
+			ce.Type = value
+		case "subject":
+			ce.Subject = value
+		case "time":
+			t, err := time.Parse(time.RFC3339Nano, value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: header %s: %v", ErrInvalidCloudEvent, h.Key, err)
+			}
+			ce.Time = t
+		default:
+			ce.setExtension(name, value)
+		}
+	}
+	return FromCloudEvent(ce)
+}
@@ -0,0 +1,117 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// samplePayload returns a registered payload with base fields set and every
+// top-level string field filled, so partition keys and subjects are non-empty
+func samplePayload(t *testing.T, eventType EventType) Event {
+	t.Helper()
+	payload, err := NewPayload(eventType)
+	if err != nil {
+		t.Fatalf("%s: %v", eventType, err)
+	}
+	v := reflect.ValueOf(payload).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() == reflect.String && f.CanSet() {
+			f.SetString("sample-" + v.Type().Field(i).Name)
+		}
+	}
+
+	e := payload.(Event)
+	*e.EventBase() = BaseEvent{
+		ID:            "evt-" + string(eventType),
+		Type:          eventType,
+		Timestamp:     time.Date(2025, 3, 14, 15, 9, 26, 535000000, time.UTC),
+		Source:        "phlx-contracts-test",
+		SchemaVersion: DefaultSchemaVersion,
+		CorrelationID: "evt-root",
+		CausationID:   "evt-parent",
+		TraceParent:   "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		TraceState:    "phlx=1",
+		AggregateID:   "agg-1",
+		Sequence:      7,
+	}
+	return e
+}
+
+func TestCloudEventStructuredRoundTrip(t *testing.T) {
+	for _, eventType := range RegisteredTypes() {
+		t.Run(string(eventType), func(t *testing.T) {
+			want := samplePayload(t, eventType)
+
+			data, err := EncodeCloudEventStructured(want)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			var attrs map[string]any
+			if err := json.Unmarshal(data, &attrs); err != nil {
+				t.Fatalf("structured event is not JSON: %v", err)
+			}
+			if attrs["specversion"] != "1.0" || attrs["type"] != string(eventType) || attrs["id"] != want.EventBase().ID {
+				t.Errorf("unexpected attributes: %v", attrs)
+			}
+
+			got, err := DecodeCloudEventStructured(data)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip mismatch\n got: %+v\nwant: %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestCloudEventBinaryRoundTrip(t *testing.T) {
+	for _, eventType := range RegisteredTypes() {
+		t.Run(string(eventType), func(t *testing.T) {
+			want := samplePayload(t, eventType)
+
+			msg, err := EncodeCloudEventBinary(want)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			if v, _ := msg.Header("ce_type"); v != string(eventType) {
+				t.Errorf("ce_type header = %q", v)
+			}
+			if v, _ := msg.Header("ce_time"); v != "2025-03-14T15:09:26.535Z" {
+				t.Errorf("ce_time header = %q", v)
+			}
+			if string(msg.Key) != PartitionKeyFor(want) {
+				t.Errorf("record key = %q, want partition key %q", msg.Key, PartitionKeyFor(want))
+			}
+
+			got, err := DecodeCloudEventBinary(msg)
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip mismatch\n got: %+v\nwant: %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestCloudEventBinaryWithoutTopicRoute(t *testing.T) {
+	unrouted := &privatePayload{BaseEvent: BaseEvent{ID: "evt-1", Type: "not_routed", Source: "test"}}
+	if _, err := EncodeCloudEventBinary(unrouted); !errors.Is(err, ErrNoTopicRoute) {
+		t.Errorf("EncodeCloudEventBinary() = %v, want ErrNoTopicRoute", err)
+	}
+	if _, err := EncodeMessage(unrouted); !errors.Is(err, ErrNoTopicRoute) {
+		t.Errorf("EncodeMessage() = %v, want ErrNoTopicRoute", err)
+	}
+}
+
+func TestCloudEventMissingAttributes(t *testing.T) {
+	_, err := DecodeCloudEventStructured([]byte(`{"specversion":"1.0","type":"mission_created"}`))
+	if !errors.Is(err, ErrInvalidCloudEvent) {
+		t.Fatalf("expected ErrInvalidCloudEvent, got %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"reflect"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Wire formats for Kafka record values.
+//
+// Producers publish with EncodeMessage, which encodes the value with the codec
+// configured for the topic and records it in the content-type header. Consumers
+// decode with DecodeMessage, which follows the header, so a topic can move from
+// JSON to Protobuf once its consumers are upgraded:
+//
+//	events.SetTopicCodec(events.KafkaTopics.LocationUpdates, events.ProtobufCodec)
+//
+// Records without a content-type header are JSON.
+
+const (
+	// ContentTypeJSON is the content type of JSON payloads
+	ContentTypeJSON = CloudEventsDataContentType
+	// ContentTypeProtobuf is the content type of Protobuf payloads, see proto.go
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// ErrUnsupportedContentType is returned when no codec handles a content type
+var ErrUnsupportedContentType = errors.New("events: unsupported content type")
+
+// Codec encodes payloads to and from a Kafka record value
+type Codec interface {
+	// ContentType is the value of the content-type header for this codec
+	ContentType() string
+	Marshal(payload Event) ([]byte, error)
+	// Unmarshal decodes the value of an event of the given type
+	Unmarshal(eventType EventType, data []byte) (Event, error)
+}
+
+var (
+	// JSONCodec encodes payloads as the flat JSON producers send today
+	JSONCodec Codec = jsonCodec{}
+	// ProtobufCodec encodes payloads as their phlx.events.v1 messages
+	ProtobufCodec Codec = protobufCodec{}
+)
+
+type jsonCodec struct{}
+
+func (jsonCodec) ContentType() string { return ContentTypeJSON }
+
+func (jsonCodec) Marshal(payload Event) ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// Unmarshal upcasts and decodes the payload; an empty eventType accepts any registered type
+func (jsonCodec) Unmarshal(eventType EventType, data []byte) (Event, error) {
+	payload, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	e, ok := payload.(Event)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T does not embed BaseEvent", ErrPayloadMismatch, payload)
+	}
+	if eventType != "" && e.EventBase().Type != eventType {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrPayloadMismatch, eventType, e.EventBase().Type)
+	}
+	return e, nil
+}
+
+type protobufCodec struct{}
+
+func (protobufCodec) ContentType() string { return ContentTypeProtobuf }
+
+func (protobufCodec) Marshal(payload Event) ([]byte, error) {
+	m, err := ToProto(payload)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(m)
+}
+
+func (protobufCodec) Unmarshal(eventType EventType, data []byte) (Event, error) {
+	payload, err := NewPayload(eventType)
+	if err != nil {
+		return nil, err
+	}
+	mt, err := protoMessageType(reflect.TypeOf(payload).Elem())
+	if err != nil {
+		return nil, err
+	}
+	m := mt.New()
+	if err := proto.Unmarshal(data, m.Interface()); err != nil {
+		return nil, fmt.Errorf("events: decode %q payload: %w", eventType, err)
+	}
+	e, err := FromProto(m.Interface())
+	if err != nil {
+		return nil, err
+	}
+	if e.EventBase().Type != eventType {
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrPayloadMismatch, eventType, e.EventBase().Type)
+	}
+	return e, nil
+}
+
+// codecs maps content types to codecs
+var codecs = struct {
+	sync.RWMutex
+	m map[string]Codec
+}{m: map[string]Codec{
+	ContentTypeJSON:     JSONCodec,
+	ContentTypeProtobuf: ProtobufCodec,
+}}
+
+// topicCodecs maps topics to the codec producers use; unlisted topics use JSONCodec
+var topicCodecs = struct {
+	sync.RWMutex
+	m map[string]Codec
+}{m: map[string]Codec{}}
+
+// RegisterCodec makes a codec available for decoding its content type
+func RegisterCodec(codec Codec) {
+	codecs.Lock()
+	defer codecs.Unlock()
+	codecs.m[codec.ContentType()] = codec
+}
+
+// CodecFor returns the codec for a content-type header value; parameters are ignored
+func CodecFor(contentType string) (Codec, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedContentType, contentType)
+	}
+	codecs.RLock()
+	defer codecs.RUnlock()
+	codec, ok := codecs.m[mediaType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedContentType, contentType)
+	}
+	return codec, nil
+}
+
+// SetTopicCodec sets the codec producers use for a topic and registers it for decoding
+func SetTopicCodec(topic string, codec Codec) {
+	RegisterCodec(codec)
+	topicCodecs.Lock()
+	defer topicCodecs.Unlock()
+	topicCodecs.m[topic] = codec
+}
+
+// CodecForTopic returns the codec producers use for a topic or a tenant's copy of it
+func CodecForTopic(topic string) Codec {
+	topicCodecs.RLock()
+	defer topicCodecs.RUnlock()
+	if codec, ok := topicCodecs.m[topic]; ok {
+		return codec
+	}
+	// Tenant topics use the codec of their topic
+	if _, base := SplitTenantTopic(topic); base != topic {
+		if codec, ok := topicCodecs.m[base]; ok {
+			return codec
+		}
+	}
+	return JSONCodec
+}
+
+// EncodeMessage encodes a payload as a Kafka binary-mode CloudEvent whose value is
+// encoded with the codec of the payload's topic
+func EncodeMessage(payload Event) (*KafkaMessage, error) {
+	msg, err := EncodeCloudEventBinary(payload)
+	if err != nil {
+		return nil, err
+	}
+	codec := CodecForTopic(msg.Topic)
+	if codec.ContentType() == ContentTypeJSON {
+		return msg, nil
+	}
+	if msg.Value, err = codec.Marshal(payload); err != nil {
+		return nil, err
+	}
+	msg.SetHeader(contentTypeHeader, codec.ContentType())
+	return msg, nil
+}
+
+// DecodeMessage decodes a Kafka record with the codec named by its content-type header.
+// Records without CloudEvents headers are decoded as plain JSON payloads. Records with
+// claim references return ErrClaimNotRehydrated until ClaimCheck.Rehydrate restores them.
+func DecodeMessage(msg *KafkaMessage) (any, error) {
+	if err := checkRehydrated(msg); err != nil {
+		return nil, err
+	}
+	ct, _ := msg.Header(contentTypeHeader)
+	if _, ok := msg.Header(cloudEventsHeaderPrefix + "specversion"); ok || strings.HasPrefix(ct, CloudEventsContentType) {
+		return DecodeCloudEventBinary(msg)
+	}
+	if ct != "" {
+		codec, err := CodecFor(ct)
+		if err != nil {
+			return nil, err
+		}
+		if codec.ContentType() != ContentTypeJSON {
+			return nil, fmt.Errorf("%w: %s record without a ce_type header", ErrUnsupportedContentType, ct)
+		}
+	}
+	return Decode(msg.Value)
+}
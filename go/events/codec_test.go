@@ -0,0 +1,147 @@
+package events
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fill sets every field reachable from v to a non-zero value, so round trips
+// exercise nested messages, lists, timestamps and metadata
+func fill(v reflect.Value, name string) {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("sample-" + name)
+	case reflect.Int, reflect.Int64:
+		v.SetInt(42)
+	case reflect.Float64:
+		v.SetFloat(12.5)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem(), name)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(v.Index(0), name)
+	case reflect.Map:
+		v.Set(reflect.ValueOf(map[string]interface{}{"key": "value", "count": 3.0}))
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Date(2025, 3, 14, 15, 9, 26, 535000000, time.UTC)))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fill(v.Field(i), v.Type().Field(i).Name)
+			}
+		}
+	}
+}
+
+// fullPayload returns a registered payload with every field set
+func fullPayload(t *testing.T, eventType EventType) Event {
+	t.Helper()
+	e := samplePayload(t, eventType)
+	base := *e.EventBase()
+	fill(reflect.ValueOf(e).Elem(), "")
+	*e.EventBase() = base
+	return e
+}
+
+func TestProtoRoundTrip(t *testing.T) {
+	for _, eventType := range RegisteredTypes() {
+		t.Run(string(eventType), func(t *testing.T) {
+			for name, want := range map[string]Event{
+				"sparse": samplePayload(t, eventType),
+				"full":   fullPayload(t, eventType),
+			} {
+				m, err := ToProto(want)
+				if err != nil {
+					t.Fatalf("%s: to proto: %v", name, err)
+				}
+				got, err := FromProto(m)
+				if err != nil {
+					t.Fatalf("%s: from proto: %v", name, err)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s: round trip mismatch\n got: %+v\nwant: %+v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	for _, codec := range []Codec{JSONCodec, ProtobufCodec} {
+		t.Run(codec.ContentType(), func(t *testing.T) {
+			want := fullPayload(t, LocationUpdateEvent)
+			data, err := codec.Marshal(want)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			got, err := codec.Unmarshal(LocationUpdateEvent, data)
+			if err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip mismatch\n got: %+v\nwant: %+v", got, want)
+			}
+			if _, err := codec.Unmarshal(VitalsUpdateEvent, data); !errors.Is(err, ErrPayloadMismatch) {
+				t.Errorf("expected ErrPayloadMismatch for the wrong event type, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEncodeMessageUsesTopicCodec(t *testing.T) {
+	topic := KafkaTopics.LocationUpdates
+	SetTopicCodec(topic, ProtobufCodec)
+	defer func() {
+		topicCodecs.Lock()
+		delete(topicCodecs.m, topic)
+		topicCodecs.Unlock()
+	}()
+
+	want := fullPayload(t, LocationUpdateEvent)
+	msg, err := EncodeMessage(want)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if ct, _ := msg.Header(contentTypeHeader); ct != ContentTypeProtobuf {
+		t.Errorf("content-type = %q, want %q", ct, ContentTypeProtobuf)
+	}
+	got, err := DecodeMessage(msg)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip mismatch\n got: %+v\nwant: %+v", got, want)
+	}
+
+	// Topics without a codec stay JSON
+	vitals, err := EncodeMessage(fullPayload(t, VitalsUpdateEvent))
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if ct, _ := vitals.Header(contentTypeHeader); ct != ContentTypeJSON {
+		t.Errorf("content-type = %q, want %q", ct, ContentTypeJSON)
+	}
+}
+
+func TestDecodeMessagePlainJSON(t *testing.T) {
+	msg := &KafkaMessage{Value: []byte(`{"id":"evt-1","type":"mission_created","timestamp":"2025-03-14T15:09:26Z","source":"legacy","missionId":"m-1"}`)}
+	got, err := DecodeMessage(msg)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if e, ok := got.(*MissionCreatedEventData); !ok || e.MissionID != "m-1" {
+		t.Errorf("unexpected payload %#v", got)
+	}
+
+	msg.SetHeader(contentTypeHeader, "application/xml")
+	if _, err := DecodeMessage(msg); !errors.Is(err, ErrUnsupportedContentType) {
+		t.Errorf("expected ErrUnsupportedContentType, got %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// Event constructors fill BaseEvent so producers never build it by hand:
+//   - ID is a new ULID, so IDs sort by creation time
+//   - Type is fixed by the constructor, so it always matches the payload
+//   - Timestamp is UTC time from the configured clock
+//   - Source is the service identity set with SetSource
+//   - SchemaVersion is CurrentSchemaVersion of the type
+//   - CorrelationID is the event's own ID; DeriveFrom replaces it for follow-up events
+//
+// Services call SetSource once at startup:
+//
+//	events.SetSource("mission-command-service")
+//	e := events.NewMissionCreated(events.MissionCreatedEventData{MissionID: m.ID.Hex(), ...})
+//
+// Any BaseEvent set on the data passed to a constructor is replaced.
+
+// identity is the service identity and clock stamped on new events
+var identity = struct {
+	sync.RWMutex
+	source string
+	clock  func() time.Time
+}{clock: time.Now}
+
+// SetSource sets the Source of events built by this process, normally the service name
+func SetSource(source string) {
+	identity.Lock()
+	defer identity.Unlock()
+	identity.source = source
+}
+
+// SetClock replaces the clock used for timestamps and ULIDs, e.g. in tests; nil restores time.Now
+func SetClock(clock func() time.Time) {
+	if clock == nil {
+		clock = time.Now
+	}
+	identity.Lock()
+	defer identity.Unlock()
+	identity.clock = clock
+}
+
+// Now returns the time of the clock set by SetClock, in UTC
+func Now() time.Time {
+	return now()
+}
+
+// now returns the configured clock's time in UTC
+func now() time.Time {
+	identity.RLock()
+	clock := identity.clock
+	identity.RUnlock()
+	return clock().UTC()
+}
+
+// NewBaseEvent returns the metadata for a new event of the given type
+func NewBaseEvent(eventType EventType) BaseEvent {
+	t := now()
+	identity.RLock()
+	source := identity.source
+	identity.RUnlock()
+	id := newULIDAt(t)
+	return BaseEvent{
+		ID:            id,
+		Type:          eventType,
+		Timestamp:     t,
+		Source:        source,
+		SchemaVersion: CurrentSchemaVersion(eventType),
+		CorrelationID: id,
+	}
+}
+
+// newEvent stamps a new BaseEvent of the given type on the payload
+func newEvent[T any, P interface {
+	*T
+	Event
+}](eventType EventType, data T) *T {
+	*P(&data).EventBase() = NewBaseEvent(eventType)
+	return &data
+}
+
+// NewAssetUpdate builds an asset_update event
+func NewAssetUpdate(data AssetUpdateEventData) *AssetUpdateEventData {
+	return newEvent(AssetUpdateEvent, data)
+}
+
+// NewAssetRecall builds an asset_recall event for a recall order
+func NewAssetRecall(asset *models.Asset, recall models.AssetRecall) *AssetRecallEventData {
+	return NewAssetRecallEventData(NewBaseEvent(AssetRecallEvent), asset, recall)
+}
+
+// NewEmergencyNotification builds an emergency_notification event
+func NewEmergencyNotification(data EmergencyNotificationEventData) *EmergencyNotificationEventData {
+	return newEvent(EmergencyNotification, data)
+}
+
+// NewChatMessage builds a chat_message event
+func NewChatMessage(data ChatMessageEventData) *ChatMessageEventData {
+	return newEvent(ChatMessageEvent, data)
+}
+
+// NewSystemStatus builds a system_status event
+func NewSystemStatus(data SystemStatusEventData) *SystemStatusEventData {
+	return newEvent(SystemStatusEvent, data)
+}
+
+// NewLocationUpdate builds a location_update event
+func NewLocationUpdate(data LocationUpdateEventData) *LocationUpdateEventData {
+	return newEvent(LocationUpdateEvent, data)
+}
+
+// NewVitalsUpdate builds a vitals_update event
+func NewVitalsUpdate(data VitalsUpdateEventData) *VitalsUpdateEventData {
+	return newEvent(VitalsUpdateEvent, data)
+}
+
+// NewVideoUpload builds a video_upload event
+func NewVideoUpload(data VideoUploadEventData) *VideoUploadEventData {
+	return newEvent(VideoUploadEvent, data)
+}
+
+// NewVideoProcessing builds a video_processing event
+func NewVideoProcessing(data VideoProcessingEventData) *VideoProcessingEventData {
+	return newEvent(VideoProcessingEvent, data)
+}
+
+// NewFrameExtraction builds a frame_extraction event
+func NewFrameExtraction(data FrameExtractionEventData) *FrameExtractionEventData {
+	return newEvent(FrameExtractionEvent, data)
+}
+
+// NewFrameUploadComplete builds a frame_upload_complete event
+func NewFrameUploadComplete(data FrameUploadCompleteEventData) *FrameUploadCompleteEventData {
+	return newEvent(FrameUploadCompleteEvent, data)
+}
+
+// NewAIAnalysis builds an ai_analysis event
+func NewAIAnalysis(data AIAnalysisEventData) *AIAnalysisEventData {
+	return newEvent(AIAnalysisEvent, data)
+}
+
+// NewEventAnalysis builds an event_analysis event
+func NewEventAnalysis(data EventAnalysisEventData) *EventAnalysisEventData {
+	return newEvent(EventAnalysisEvent, data)
+}
+
+// NewSuggestionCreated builds a suggestion_created event
+func NewSuggestionCreated(data SuggestionCreatedEventData) *SuggestionCreatedEventData {
+	return newEvent(SuggestionCreated, data)
+}
+
+// NewMissionCreated builds a mission_created event
+func NewMissionCreated(data MissionCreatedEventData) *MissionCreatedEventData {
+	return newEvent(MissionCreated, data)
+}
+
+// NewAIMissionSuggestion builds an ai_mission_suggestion event
+func NewAIMissionSuggestion(data AIMissionSuggestionEventData) *AIMissionSuggestionEventData {
+	return newEvent(AIMissionSuggestion, data)
+}
+
+// NewTacticalCommandCreated builds a tactical_command_created event
+func NewTacticalCommandCreated(data TacticalCommandCreatedEventData) *TacticalCommandCreatedEventData {
+	return newEvent(TacticalCommandCreated, data)
+}
+
+// NewTacticalCommandResponse builds a tactical_command_response event
+func NewTacticalCommandResponse(data TacticalCommandResponseEventData) *TacticalCommandResponseEventData {
+	return newEvent(TacticalCommandResponse, data)
+}
+
+// NewTacticalCommandStatusChanged builds a tactical_command_status_changed event
+func NewTacticalCommandStatusChanged(data TacticalCommandStatusEventData) *TacticalCommandStatusEventData {
+	return newEvent(TacticalCommandStatusChanged, data)
+}
+
+// NewFireAlertCreated builds a fire.alert.created event for an alert
+func NewFireAlertCreated(alert models.Alert, fireEvent *models.FireEvent) *FireAlertCreatedEventData {
+	return NewFireAlertCreatedEventData(NewBaseEvent(FireAlertCreatedEvent), alert, fireEvent)
+}
+
+// NewFireRiskDetected builds a fire.risk.detected event
+func NewFireRiskDetected(fireEvent models.FireEvent) *FireRiskEventData {
+	return &FireRiskEventData{BaseEvent: NewBaseEvent(FireRiskDetectedEvent), FireEvent: fireEvent}
+}
+
+// NewFireRiskUpdated builds a fire.risk.updated event
+func NewFireRiskUpdated(fireEvent models.FireEvent) *FireRiskEventData {
+	return &FireRiskEventData{BaseEvent: NewBaseEvent(FireRiskUpdatedEvent), FireEvent: fireEvent}
+}
+
+// NewFireRiskCleared builds a fire.risk.cleared event
+func NewFireRiskCleared(fireEvent models.FireEvent) *FireRiskEventData {
+	return &FireRiskEventData{BaseEvent: NewBaseEvent(FireRiskClearedEvent), FireEvent: fireEvent}
+}
+
+// NewMissionChatMessage builds a mission_chat_message event
+func NewMissionChatMessage(data MissionChatMessageEventData) *MissionChatMessageEventData {
+	return newEvent(MissionChatMessageEvent, data)
+}
+
+// NewMissionTypingIndicator builds a mission_typing_indicator event
+func NewMissionTypingIndicator(data MissionTypingIndicatorEventData) *MissionTypingIndicatorEventData {
+	return newEvent(MissionTypingIndicatorEvent, data)
+}
@@ -0,0 +1,97 @@
+package events
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+func TestConstructorsStampBaseEvent(t *testing.T) {
+	clock := time.Date(2025, 3, 14, 16, 9, 26, 535000000, time.FixedZone("CET", 3600))
+	SetClock(func() time.Time { return clock })
+	SetSource("phlx-contracts-test")
+	defer SetClock(nil)
+	defer SetSource("")
+
+	constructors := map[EventType]func() Event{
+		AssetUpdateEvent:             func() Event { return NewAssetUpdate(AssetUpdateEventData{}) },
+		AssetRecallEvent:             func() Event { return NewAssetRecall(nil, models.AssetRecall{}) },
+		EmergencyNotification:        func() Event { return NewEmergencyNotification(EmergencyNotificationEventData{}) },
+		ChatMessageEvent:             func() Event { return NewChatMessage(ChatMessageEventData{}) },
+		SystemStatusEvent:            func() Event { return NewSystemStatus(SystemStatusEventData{}) },
+		LocationUpdateEvent:          func() Event { return NewLocationUpdate(LocationUpdateEventData{}) },
+		VitalsUpdateEvent:            func() Event { return NewVitalsUpdate(VitalsUpdateEventData{}) },
+		VideoUploadEvent:             func() Event { return NewVideoUpload(VideoUploadEventData{}) },
+		VideoProcessingEvent:         func() Event { return NewVideoProcessing(VideoProcessingEventData{}) },
+		FrameExtractionEvent:         func() Event { return NewFrameExtraction(FrameExtractionEventData{}) },
+		FrameUploadCompleteEvent:     func() Event { return NewFrameUploadComplete(FrameUploadCompleteEventData{}) },
+		AIAnalysisEvent:              func() Event { return NewAIAnalysis(AIAnalysisEventData{}) },
+		EventAnalysisEvent:           func() Event { return NewEventAnalysis(EventAnalysisEventData{}) },
+		SuggestionCreated:            func() Event { return NewSuggestionCreated(SuggestionCreatedEventData{}) },
+		MissionCreated:               func() Event { return NewMissionCreated(MissionCreatedEventData{}) },
+		AIMissionSuggestion:          func() Event { return NewAIMissionSuggestion(AIMissionSuggestionEventData{}) },
+		TacticalCommandCreated:       func() Event { return NewTacticalCommandCreated(TacticalCommandCreatedEventData{}) },
+		TacticalCommandResponse:      func() Event { return NewTacticalCommandResponse(TacticalCommandResponseEventData{}) },
+		TacticalCommandStatusChanged: func() Event { return NewTacticalCommandStatusChanged(TacticalCommandStatusEventData{}) },
+		FireAlertCreatedEvent:        func() Event { return NewFireAlertCreated(models.Alert{}, nil) },
+		FireRiskDetectedEvent:        func() Event { return NewFireRiskDetected(models.FireEvent{}) },
+		FireRiskUpdatedEvent:         func() Event { return NewFireRiskUpdated(models.FireEvent{}) },
+		FireRiskClearedEvent:         func() Event { return NewFireRiskCleared(models.FireEvent{}) },
+		MissionChatMessageEvent:      func() Event { return NewMissionChatMessage(MissionChatMessageEventData{}) },
+		MissionTypingIndicatorEvent:  func() Event { return NewMissionTypingIndicator(MissionTypingIndicatorEventData{}) },
+	}
+
+	for _, eventType := range RegisteredTypes() {
+		newEvent, ok := constructors[eventType]
+		if !ok {
+			t.Errorf("%s: no constructor", eventType)
+			continue
+		}
+		base := newEvent().EventBase()
+		if base.Type != eventType {
+			t.Errorf("%s: constructor set type %q", eventType, base.Type)
+		}
+		if !base.Timestamp.Equal(clock) || base.Timestamp.Location() != time.UTC {
+			t.Errorf("%s: timestamp = %v, want %v in UTC", eventType, base.Timestamp, clock)
+		}
+		if base.Source != "phlx-contracts-test" {
+			t.Errorf("%s: source = %q", eventType, base.Source)
+		}
+		if idTime, err := ULIDTime(base.ID); err != nil || !idTime.Equal(clock) {
+			t.Errorf("%s: ID %q is not a ULID for the clock time: %v", eventType, base.ID, err)
+		}
+	}
+}
+
+func TestULIDsSortInCreationOrder(t *testing.T) {
+	at := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	ulids.Lock()
+	ulids.ms = 0
+	ulids.Unlock()
+
+	var ids []string
+	for i := 0; i < 1000; i++ {
+		// Many IDs share a millisecond, and the clock steps back once
+		ts := at.Add(time.Duration(i/100) * time.Millisecond)
+		if i == 500 {
+			ts = at
+		}
+		ids = append(ids, newULIDAt(ts))
+	}
+	if !sort.StringsAreSorted(ids) {
+		t.Fatal("ULIDs are not sorted in creation order")
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] == ids[i-1] {
+			t.Fatalf("duplicate ULID %s", ids[i])
+		}
+	}
+	if got, err := ULIDTime(ids[0]); err != nil || !got.Equal(at) {
+		t.Errorf("ULIDTime = %v, %v; want %v", got, err, at)
+	}
+	if _, err := ULIDTime("not-a-ulid"); err == nil {
+		t.Error("expected an error for an invalid ULID")
+	}
+}
@@ -0,0 +1,338 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Retry topics and dead letters.
+//
+// A consumer that fails to process a record must not block its partition. It publishes
+// the record to the next hop chosen by the topic's RetryPolicy and commits the offset:
+//
+//	frame-extraction → frame-extraction.retry.30s → frame-extraction.retry.5m → frame-extraction.dlq
+//
+// Retry consumers read the .retry topics, wait until the record's RetryAfterHeader and
+// process it again. Every failure is appended to the record's AttemptsHeader. The .dlq
+// topic holds DeadLetterEvent values, which keep the original record for inspection and
+// replay.
+//
+//	if err := handle(ctx, msg); err != nil {
+//		next, rerr := events.RetryPolicyFor(msg.Topic).Route(msg, partition, offset, err)
+//		...publish next, then commit offset
+//	}
+
+const (
+	// AttemptsHeader holds the JSON array of failed DeliveryAttempts of a retried record
+	AttemptsHeader = "phlx-attempts"
+	// RetryAfterHeader holds the RFC 3339 time before which a retry consumer must not process the record
+	RetryAfterHeader = "phlx-retry-after"
+
+	retryTopicInfix = ".retry."
+	dlqTopicSuffix  = ".dlq"
+)
+
+// ErrPermanent marks failures that retrying cannot fix; such records go straight to the DLQ
+var ErrPermanent = errors.New("events: permanent failure")
+
+// Permanent wraps err so the retry policy dead-letters the record at once
+func Permanent(err error) error {
+	return fmt.Errorf("%w: %w", ErrPermanent, err)
+}
+
+// IsPermanent reports whether err is a permanent failure: marked with Permanent, or a
+// record that cannot be decoded or does not pass validation
+func IsPermanent(err error) bool {
+	var (
+		verrs     ValidationErrors
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+	)
+	return errors.Is(err, ErrPermanent) ||
+		errors.Is(err, ErrUnknownEventType) ||
+		errors.Is(err, ErrPayloadMismatch) ||
+		errors.Is(err, ErrInvalidCloudEvent) ||
+		errors.Is(err, ErrUnsupportedContentType) ||
+		errors.As(err, &verrs) ||
+		errors.As(err, &syntaxErr) ||
+		errors.As(err, &typeErr)
+}
+
+// DeliveryAttempt is one failed attempt to process a record
+type DeliveryAttempt struct {
+	Topic     string    `json:"topic"`
+	Partition int32     `json:"partition"`
+	Offset    int64     `json:"offset"`
+	Error     string    `json:"error"`
+	FailedAt  time.Time `json:"failedAt"`
+}
+
+// DeadLetterEvent is the value of a .dlq record: the original record and why it failed
+type DeadLetterEvent struct {
+	OriginalTopic  string            `json:"originalTopic"`
+	Partition      int32             `json:"partition"` // of the last attempt
+	Offset         int64             `json:"offset"`    // of the last attempt
+	Key            []byte            `json:"key,omitempty"`
+	Value          []byte            `json:"value"`
+	Headers        []KafkaHeader     `json:"headers,omitempty"` // original headers, without retry headers
+	EventID        string            `json:"eventId,omitempty"`
+	EventType      EventType         `json:"eventType,omitempty"`
+	Error          string            `json:"error"` // of the last attempt
+	Attempts       []DeliveryAttempt `json:"attempts"`
+	DeadLetteredAt time.Time         `json:"deadLetteredAt"`
+}
+
+// Replay returns the original record, to publish again to its topic once the cause is fixed
+func (d *DeadLetterEvent) Replay() *KafkaMessage {
+	return &KafkaMessage{
+		Topic:   d.OriginalTopic,
+		Key:     d.Key,
+		Value:   d.Value,
+		Headers: append([]KafkaHeader(nil), d.Headers...),
+	}
+}
+
+// DecodeDeadLetter reads the value of a .dlq record
+func DecodeDeadLetter(msg *KafkaMessage) (*DeadLetterEvent, error) {
+	var d DeadLetterEvent
+	if err := json.Unmarshal(msg.Value, &d); err != nil {
+		return nil, fmt.Errorf("events: decode dead letter: %w", err)
+	}
+	return &d, nil
+}
+
+// RetryTopic returns the retry topic of a topic for a delay, e.g. "ai-analysis.retry.5m"
+func RetryTopic(topic string, delay time.Duration) string {
+	return BaseTopic(topic) + retryTopicInfix + formatDelay(delay)
+}
+
+// DLQTopic returns the dead-letter topic of a topic, e.g. "ai-analysis.dlq"
+func DLQTopic(topic string) string {
+	return BaseTopic(topic) + dlqTopicSuffix
+}
+
+// BaseTopic strips a retry or dead-letter suffix, returning the topic events are published to
+func BaseTopic(topic string) string {
+	if i := strings.LastIndex(topic, retryTopicInfix); i >= 0 {
+		if _, err := time.ParseDuration(topic[i+len(retryTopicInfix):]); err == nil {
+			return topic[:i]
+		}
+	}
+	return strings.TrimSuffix(topic, dlqTopicSuffix)
+}
+
+// retryDelay returns the delay of a retry topic, or false for other topics
+func retryDelay(topic string) (time.Duration, bool) {
+	i := strings.LastIndex(topic, retryTopicInfix)
+	if i < 0 {
+		return 0, false
+	}
+	d, err := time.ParseDuration(topic[i+len(retryTopicInfix):])
+	return d, err == nil
+}
+
+// formatDelay writes a delay in its largest whole unit: 30s, 5m, 1h
+func formatDelay(d time.Duration) string {
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d >= time.Minute && d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return fmt.Sprintf("%ds", (d+time.Second-1)/time.Second)
+	}
+}
+
+// Topics returns the values of KafkaTopics, sorted
+func Topics() []string {
+	v := reflect.ValueOf(KafkaTopics)
+	topics := make([]string, 0, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		topics = append(topics, v.Field(i).String())
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// RetryPolicy decides where a record goes after a failed attempt
+type RetryPolicy struct {
+	// Delays lists the retry tiers in order, each with its own topic; empty sends
+	// failures straight to the DLQ
+	Delays []time.Duration
+	// Retryable reports whether a failure may be retried; nil retries every
+	// failure that is not IsPermanent
+	Retryable func(error) bool
+}
+
+// DefaultRetryPolicy retries after 30 seconds and again after 5 minutes
+var DefaultRetryPolicy = RetryPolicy{Delays: []time.Duration{30 * time.Second, 5 * time.Minute}}
+
+// Hop is the next topic of a failed record
+type Hop struct {
+	Topic      string
+	RetryAfter time.Time // zero for the DLQ
+	DeadLetter bool
+}
+
+// Topics returns the retry topics and the DLQ of a topic, in hop order
+func (p RetryPolicy) Topics(topic string) []string {
+	out := make([]string, 0, len(p.Delays)+1)
+	for _, d := range p.Delays {
+		out = append(out, RetryTopic(topic, d))
+	}
+	return append(out, DLQTopic(topic))
+}
+
+// Next returns the hop after a record consumed from topic failed with err at time now
+func (p RetryPolicy) Next(topic string, err error, now time.Time) Hop {
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = func(err error) bool { return !IsPermanent(err) }
+	}
+	if retryable(err) {
+		// The next tier is the first one longer than the tier the record came from
+		current, _ := retryDelay(topic)
+		for _, d := range p.Delays {
+			if d > current {
+				return Hop{Topic: RetryTopic(topic, d), RetryAfter: now.Add(d)}
+			}
+		}
+	}
+	return Hop{Topic: DLQTopic(topic), DeadLetter: true}
+}
+
+// Route returns the record to publish after msg, read from partition and offset of
+// msg.Topic, failed with err: a copy for the next retry topic, or a DeadLetterEvent
+func (p RetryPolicy) Route(msg *KafkaMessage, partition int32, offset int64, err error) (*KafkaMessage, error) {
+	t := now()
+	attempts, herr := attemptsOf(msg)
+	if herr != nil {
+		return nil, herr
+	}
+	attempts = append(attempts, DeliveryAttempt{
+		Topic: msg.Topic, Partition: partition, Offset: offset, Error: err.Error(), FailedAt: t,
+	})
+
+	hop := p.Next(msg.Topic, err, t)
+	original := withoutRetryHeaders(msg.Headers)
+	if !hop.DeadLetter {
+		history, err := json.Marshal(attempts)
+		if err != nil {
+			return nil, err
+		}
+		next := &KafkaMessage{Topic: hop.Topic, Key: msg.Key, Value: msg.Value, Headers: original}
+		next.SetHeader(AttemptsHeader, string(history))
+		next.SetHeader(RetryAfterHeader, hop.RetryAfter.Format(time.RFC3339Nano))
+		return next, nil
+	}
+
+	dead := DeadLetterEvent{
+		OriginalTopic:  BaseTopic(msg.Topic),
+		Partition:      partition,
+		Offset:         offset,
+		Key:            msg.Key,
+		Value:          msg.Value,
+		Headers:        original,
+		Error:          err.Error(),
+		Attempts:       attempts,
+		DeadLetteredAt: t,
+	}
+	dead.EventID, dead.EventType = identify(msg)
+	value, merr := json.Marshal(dead)
+	if merr != nil {
+		return nil, merr
+	}
+	next := &KafkaMessage{Topic: hop.Topic, Key: msg.Key, Value: value}
+	next.SetHeader(contentTypeHeader, ContentTypeJSON)
+	return next, nil
+}
+
+// RetryAfter returns the time before which a retry consumer must not process msg;
+// zero if the record has no retry header
+func RetryAfter(msg *KafkaMessage) (time.Time, error) {
+	v, ok := msg.Header(RetryAfterHeader)
+	if !ok {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("events: header %s: %w", RetryAfterHeader, err)
+	}
+	return t, nil
+}
+
+// attemptsOf reads the attempt history of a retried record
+func attemptsOf(msg *KafkaMessage) ([]DeliveryAttempt, error) {
+	v, ok := msg.Header(AttemptsHeader)
+	if !ok {
+		return nil, nil
+	}
+	var attempts []DeliveryAttempt
+	if err := json.Unmarshal([]byte(v), &attempts); err != nil {
+		return nil, fmt.Errorf("events: header %s: %w", AttemptsHeader, err)
+	}
+	return attempts, nil
+}
+
+func withoutRetryHeaders(headers []KafkaHeader) []KafkaHeader {
+	var out []KafkaHeader
+	for _, h := range headers {
+		if h.Key != AttemptsHeader && h.Key != RetryAfterHeader {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+// identify reads the event ID and type from CloudEvents headers, or from the JSON
+// value of records without them; poison records may have neither
+func identify(msg *KafkaMessage) (string, EventType) {
+	if id, ok := msg.Header(cloudEventsHeaderPrefix + "id"); ok {
+		eventType, _ := msg.Header(cloudEventsHeaderPrefix + "type")
+		return id, EventType(eventType)
+	}
+	var head struct {
+		ID   string    `json:"id"`
+		Type EventType `json:"type"`
+	}
+	if json.Unmarshal(msg.Value, &head) != nil {
+		return "", ""
+	}
+	return head.ID, head.Type
+}
+
+// retryPolicies maps topics to their retry policy; unlisted topics use DefaultRetryPolicy
+var retryPolicies = struct {
+	sync.RWMutex
+	m map[string]RetryPolicy
+}{m: map[string]RetryPolicy{}}
+
+// SetRetryPolicy sets the retry policy of a topic and its retry topics
+func SetRetryPolicy(topic string, policy RetryPolicy) {
+	retryPolicies.Lock()
+	defer retryPolicies.Unlock()
+	retryPolicies.m[BaseTopic(topic)] = policy
+}
+
+// RetryPolicyFor returns the retry policy of a topic, of one of its retry topics or of a
+// tenant's copy of it
+func RetryPolicyFor(topic string) RetryPolicy {
+	retryPolicies.RLock()
+	defer retryPolicies.RUnlock()
+	if policy, ok := retryPolicies.m[BaseTopic(topic)]; ok {
+		return policy
+	}
+	// Tenant topics share the policy of their topic
+	if _, base := SplitTenantTopic(topic); base != topic {
+		if policy, ok := retryPolicies.m[BaseTopic(base)]; ok {
+			return policy
+		}
+	}
+	return DefaultRetryPolicy
+}
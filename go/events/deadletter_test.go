@@ -0,0 +1,141 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRetryTopicNames(t *testing.T) {
+	cases := []struct{ got, want string }{
+		{RetryTopic(KafkaTopics.FrameExtraction, 30*time.Second), "frame-extraction.retry.30s"},
+		{RetryTopic(KafkaTopics.FrameExtraction, 5*time.Minute), "frame-extraction.retry.5m"},
+		{RetryTopic(KafkaTopics.AIAnalysis, 2*time.Hour), "ai-analysis.retry.2h"},
+		{RetryTopic(KafkaTopics.AIAnalysis, 90*time.Second), "ai-analysis.retry.90s"},
+		{RetryTopic("ai-analysis.retry.30s", 5*time.Minute), "ai-analysis.retry.5m"},
+		{DLQTopic(KafkaTopics.FrameExtraction), "frame-extraction.dlq"},
+		{DLQTopic("frame-extraction.retry.5m"), "frame-extraction.dlq"},
+		{BaseTopic("frame-extraction.retry.30s"), "frame-extraction"},
+		{BaseTopic("frame-extraction.dlq"), "frame-extraction"},
+		{BaseTopic(KafkaTopics.MissionEvents), "mission-events"},
+		{fmt.Sprint(DefaultRetryPolicy.Topics(KafkaTopics.FireEvents)), "[fire-events.retry.30s fire-events.retry.5m fire-events.dlq]"},
+		{fmt.Sprint(RetryPolicy{}.Topics(KafkaTopics.FireEvents)), "[fire-events.dlq]"},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("got %q, want %q", c.got, c.want)
+		}
+	}
+}
+
+func TestTopicsListsKafkaTopics(t *testing.T) {
+	topics := Topics()
+	if len(topics) != 19 || topics[0] != KafkaTopics.AIAnalysis {
+		t.Errorf("Topics() = %v", topics)
+	}
+}
+
+func TestRetryPolicyNext(t *testing.T) {
+	now := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	transient := errors.New("model server timeout")
+	hops := []struct {
+		from string
+		err  error
+		want Hop
+	}{
+		{"frame-extraction", transient, Hop{Topic: "frame-extraction.retry.30s", RetryAfter: now.Add(30 * time.Second)}},
+		{"frame-extraction.retry.30s", transient, Hop{Topic: "frame-extraction.retry.5m", RetryAfter: now.Add(5 * time.Minute)}},
+		{"frame-extraction.retry.5m", transient, Hop{Topic: "frame-extraction.dlq", DeadLetter: true}},
+		{"frame-extraction", Permanent(transient), Hop{Topic: "frame-extraction.dlq", DeadLetter: true}},
+		{"frame-extraction", ValidationErrors{{Field: "id", Message: "is required"}}, Hop{Topic: "frame-extraction.dlq", DeadLetter: true}},
+		{"frame-extraction", fmt.Errorf("decode: %w", ErrUnknownEventType), Hop{Topic: "frame-extraction.dlq", DeadLetter: true}},
+	}
+	for _, h := range hops {
+		if got := DefaultRetryPolicy.Next(h.from, h.err, now); got != h.want {
+			t.Errorf("Next(%s, %v) = %+v, want %+v", h.from, h.err, got, h.want)
+		}
+	}
+}
+
+func TestRouteThroughRetriesToDLQ(t *testing.T) {
+	msg, err := EncodeMessage(samplePayload(t, AIAnalysisEvent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	failure := errors.New("model server timeout")
+
+	for i, wantTopic := range []string{"ai-analysis.retry.30s", "ai-analysis.retry.5m"} {
+		next, err := DefaultRetryPolicy.Route(msg, 3, int64(100+i), failure)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next.Topic != wantTopic || string(next.Value) != string(msg.Value) {
+			t.Fatalf("hop %d went to %s", i, next.Topic)
+		}
+		if after, err := RetryAfter(next); err != nil || after.IsZero() {
+			t.Fatalf("hop %d retry-after = %v, %v", i, after, err)
+		}
+		msg = next
+	}
+
+	dlq, err := DefaultRetryPolicy.Route(msg, 0, 7, failure)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dlq.Topic != "ai-analysis.dlq" {
+		t.Fatalf("last hop went to %s", dlq.Topic)
+	}
+	dead, err := DecodeDeadLetter(dlq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dead.Attempts) != 3 || dead.Attempts[0].Topic != "ai-analysis" || dead.Attempts[2].Offset != 7 {
+		t.Errorf("attempt history %+v", dead.Attempts)
+	}
+	if dead.OriginalTopic != "ai-analysis" || dead.EventType != AIAnalysisEvent || dead.EventID == "" || dead.Error != failure.Error() {
+		t.Errorf("dead letter %+v", dead)
+	}
+
+	replay := dead.Replay()
+	if _, ok := replay.Header(AttemptsHeader); ok {
+		t.Error("replayed record still carries the attempt history")
+	}
+	decoded, err := DecodeMessage(replay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.(Event).EventBase().ID != dead.EventID {
+		t.Errorf("replay decoded to %+v", decoded)
+	}
+}
+
+func TestRoutePoisonRecord(t *testing.T) {
+	poison := &KafkaMessage{Topic: KafkaTopics.FrameExtraction, Value: []byte("{not json")}
+	_, err := Decode(poison.Value)
+	if !IsPermanent(err) {
+		t.Fatalf("decode error %v is not permanent", err)
+	}
+	next, err := DefaultRetryPolicy.Route(poison, 1, 42, err)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dead, err := DecodeDeadLetter(next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.Topic != "frame-extraction.dlq" || string(dead.Value) != "{not json" || dead.EventID != "" {
+		t.Errorf("poison record dead-lettered as %+v", dead)
+	}
+}
+
+func TestRetryPolicyFor(t *testing.T) {
+	custom := RetryPolicy{Delays: []time.Duration{time.Minute}}
+	SetRetryPolicy("test-retry-policy", custom)
+	if got := RetryPolicyFor("test-retry-policy.retry.1m"); len(got.Delays) != 1 {
+		t.Errorf("retry topic policy = %+v", got)
+	}
+	if got := RetryPolicyFor(KafkaTopics.AIAnalysis); len(got.Delays) != len(DefaultRetryPolicy.Delays) {
+		t.Errorf("unlisted topic policy = %+v", got)
+	}
+}
@@ -0,0 +1,240 @@
+package events
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Consumer-side deduplication of redelivered events.
+//
+// Kafka delivers at least once, so a consumer can see the same BaseEvent.ID again
+// after a rebalance or a retry. A Deduplicator remembers processed IDs for a window
+// and reports repeats:
+//
+//	dedup := events.NewDeduplicator(events.NewMemoryDedupStore(100_000), time.Hour, nil)
+//	err := dedup.Process(ctx, e, func(ctx context.Context) error {
+//		return auditLogs.Insert(ctx, entry)
+//	})
+//
+// The window should cover the longest redelivery delay the consumer expects.
+// Services running several replicas share a store such as mongodedup.Store.
+//
+// Process claims an event for a lease before running the handler and records it for the
+// window only once the handler succeeded. A consumer that crashes mid-handler leaves a
+// claim that expires with the lease, so the redelivery is processed.
+
+// DefaultDedupLease is how long Process holds an event while its handler runs
+const DefaultDedupLease = 5 * time.Minute
+
+// ErrDuplicateEvent is returned by Process when the event was already processed or is
+// being processed by another consumer
+var ErrDuplicateEvent = errors.New("events: duplicate event")
+
+// DedupStore records processed event IDs
+type DedupStore interface {
+	// MarkSeen records id until expiresAt and reports whether it was already recorded
+	// and not yet expired. It must be atomic, so concurrent callers for one ID see
+	// exactly one false.
+	MarkSeen(ctx context.Context, id string, now, expiresAt time.Time) (seen bool, err error)
+	// Confirm records id until expiresAt, replacing the expiry MarkSeen set
+	Confirm(ctx context.Context, id string, expiresAt time.Time) error
+	// Forget removes id so the event is processed again when redelivered
+	Forget(ctx context.Context, id string) error
+}
+
+// DedupMetrics receives deduplication outcomes, e.g. to export a hit rate
+type DedupMetrics interface {
+	DedupHit(eventType EventType)
+	DedupMiss(eventType EventType)
+	DedupError(eventType EventType, err error)
+}
+
+// Deduplicator reports whether events were already processed within a window
+type Deduplicator struct {
+	store   DedupStore
+	window  time.Duration
+	lease   time.Duration
+	metrics DedupMetrics
+}
+
+// NewDeduplicator returns a Deduplicator with DefaultDedupLease; metrics may be nil
+func NewDeduplicator(store DedupStore, window time.Duration, metrics DedupMetrics) *Deduplicator {
+	return &Deduplicator{store: store, window: window, lease: DefaultDedupLease, metrics: metrics}
+}
+
+// SetLease sets how long Process holds an event while its handler runs. It should
+// exceed the handler's longest run; a redelivery after the lease is processed again.
+func (d *Deduplicator) SetLease(lease time.Duration) {
+	d.lease = lease
+}
+
+// Seen records the event and reports whether it was already processed within the window.
+// Events without an ID are never reported as seen.
+func (d *Deduplicator) Seen(ctx context.Context, e Event) (bool, error) {
+	return d.mark(ctx, e, d.window)
+}
+
+// mark records the event for ttl and reports whether it was already recorded
+func (d *Deduplicator) mark(ctx context.Context, e Event, ttl time.Duration) (bool, error) {
+	base := e.EventBase()
+	if base.ID == "" {
+		return false, nil
+	}
+	t := now()
+	seen, err := d.store.MarkSeen(ctx, base.ID, t, t.Add(ttl))
+	switch {
+	case d.metrics == nil:
+	case err != nil:
+		d.metrics.DedupError(base.Type, err)
+	case seen:
+		d.metrics.DedupHit(base.Type)
+	default:
+		d.metrics.DedupMiss(base.Type)
+	}
+	return seen, err
+}
+
+// Forget removes the event from the store so a redelivery is processed again
+func (d *Deduplicator) Forget(ctx context.Context, e Event) error {
+	return d.store.Forget(ctx, e.EventBase().ID)
+}
+
+// Process runs handle unless the event was already processed or is claimed by another
+// consumer, in which case it returns ErrDuplicateEvent. The event is recorded for the
+// window only after handle succeeds; if handle fails it is forgotten, so a redelivery
+// retries it.
+func (d *Deduplicator) Process(ctx context.Context, e Event, handle func(ctx context.Context) error) error {
+	seen, err := d.mark(ctx, e, min(d.lease, d.window))
+	if err != nil {
+		return err
+	}
+	if seen {
+		return ErrDuplicateEvent
+	}
+	if err := handle(ctx); err != nil {
+		if ferr := d.Forget(ctx, e); ferr != nil {
+			return errors.Join(err, ferr)
+		}
+		return err
+	}
+	id := e.EventBase().ID
+	if id == "" {
+		return nil
+	}
+	if err := d.store.Confirm(ctx, id, now().Add(d.window)); err != nil {
+		return fmt.Errorf("events: event %s was handled but not recorded: %w", id, err)
+	}
+	return nil
+}
+
+// DedupCounter is a DedupMetrics that counts outcomes in memory
+type DedupCounter struct {
+	hits, misses, errors atomic.Int64
+}
+
+func (c *DedupCounter) DedupHit(EventType)          { c.hits.Add(1) }
+func (c *DedupCounter) DedupMiss(EventType)         { c.misses.Add(1) }
+func (c *DedupCounter) DedupError(EventType, error) { c.errors.Add(1) }
+
+// Counts returns the number of duplicates, first deliveries and store errors
+func (c *DedupCounter) Counts() (hits, misses, errors int64) {
+	return c.hits.Load(), c.misses.Load(), c.errors.Load()
+}
+
+// HitRate returns the fraction of checked events that were duplicates
+func (c *DedupCounter) HitRate() float64 {
+	hits, misses := c.hits.Load(), c.misses.Load()
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+// MemoryDedupStore is a DedupStore for a single process. It holds at most capacity IDs
+// and evicts the least recently seen ones first, so IDs can be forgotten before their
+// window ends when the consumer sees more than capacity events per window.
+type MemoryDedupStore struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List // front is most recently seen
+}
+
+type dedupEntry struct {
+	id        string
+	expiresAt time.Time
+}
+
+// NewMemoryDedupStore returns an empty store holding at most capacity IDs
+func NewMemoryDedupStore(capacity int) *MemoryDedupStore {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &MemoryDedupStore{capacity: capacity, entries: map[string]*list.Element{}, order: list.New()}
+}
+
+// MarkSeen implements DedupStore
+func (s *MemoryDedupStore) MarkSeen(_ context.Context, id string, now, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.entries[id]; ok {
+		entry := el.Value.(*dedupEntry)
+		seen := now.Before(entry.expiresAt)
+		if !seen {
+			entry.expiresAt = expiresAt
+		}
+		s.order.MoveToFront(el)
+		return seen, nil
+	}
+
+	s.entries[id] = s.order.PushFront(&dedupEntry{id: id, expiresAt: expiresAt})
+	s.evict()
+	return false, nil
+}
+
+// Confirm implements DedupStore
+func (s *MemoryDedupStore) Confirm(_ context.Context, id string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.entries[id]; ok {
+		el.Value.(*dedupEntry).expiresAt = expiresAt
+		s.order.MoveToFront(el)
+		return nil
+	}
+	s.entries[id] = s.order.PushFront(&dedupEntry{id: id, expiresAt: expiresAt})
+	s.evict()
+	return nil
+}
+
+// evict removes the least recently seen IDs over capacity; callers hold s.mu
+func (s *MemoryDedupStore) evict() {
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.entries, oldest.Value.(*dedupEntry).id)
+	}
+}
+
+// Forget implements DedupStore
+func (s *MemoryDedupStore) Forget(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.entries[id]; ok {
+		s.order.Remove(el)
+		delete(s.entries, id)
+	}
+	return nil
+}
+
+// Len returns the number of IDs held, including expired ones not yet evicted
+func (s *MemoryDedupStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
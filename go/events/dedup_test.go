@@ -0,0 +1,155 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDeduplicatorWindowAndMetrics(t *testing.T) {
+	clock := time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+	SetClock(func() time.Time { return clock })
+	defer SetClock(nil)
+
+	metrics := &DedupCounter{}
+	dedup := NewDeduplicator(NewMemoryDedupStore(10), time.Minute, metrics)
+	ctx := context.Background()
+	e := &MissionChatMessageEventData{BaseEvent: BaseEvent{ID: "evt-1", Type: MissionChatMessageEvent}}
+
+	for i, want := range []bool{false, true, true} {
+		if seen, err := dedup.Seen(ctx, e); err != nil || seen != want {
+			t.Fatalf("delivery %d: seen = %v, %v; want %v", i+1, seen, err, want)
+		}
+	}
+	if rate := metrics.HitRate(); rate < 0.66 || rate > 0.67 {
+		t.Errorf("hit rate = %v, want 2/3", rate)
+	}
+
+	// After the window the ID counts as new again
+	clock = clock.Add(time.Minute)
+	if seen, _ := dedup.Seen(ctx, e); seen {
+		t.Error("event still seen after the window")
+	}
+}
+
+func TestDeduplicatorProcessForgetsFailures(t *testing.T) {
+	dedup := NewDeduplicator(NewMemoryDedupStore(10), time.Hour, nil)
+	ctx := context.Background()
+	e := &AssetUpdateEventData{BaseEvent: BaseEvent{ID: "evt-1", Type: AssetUpdateEvent}}
+
+	calls := 0
+	failing := func(context.Context) error { calls++; return errors.New("insert failed") }
+	ok := func(context.Context) error { calls++; return nil }
+
+	if err := dedup.Process(ctx, e, failing); err == nil {
+		t.Fatal("expected the handler error")
+	}
+	if err := dedup.Process(ctx, e, ok); err != nil {
+		t.Fatalf("redelivery after a failure must be processed: %v", err)
+	}
+	if err := dedup.Process(ctx, e, ok); !errors.Is(err, ErrDuplicateEvent) {
+		t.Fatalf("expected ErrDuplicateEvent, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("handler ran %d times, want 2", calls)
+	}
+}
+
+func TestDeduplicatorRecordsAfterHandling(t *testing.T) {
+	clock := time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+	SetClock(func() time.Time { return clock })
+	defer SetClock(nil)
+
+	dedup := NewDeduplicator(NewMemoryDedupStore(10), time.Hour, nil)
+	dedup.SetLease(time.Minute)
+	ctx := context.Background()
+	ok := func(context.Context) error { return nil }
+
+	// A redelivery while the handler runs is a duplicate
+	e := &AssetUpdateEventData{BaseEvent: BaseEvent{ID: "evt-1", Type: AssetUpdateEvent}}
+	err := dedup.Process(ctx, e, func(ctx context.Context) error {
+		if err := dedup.Process(ctx, e, ok); !errors.Is(err, ErrDuplicateEvent) {
+			t.Errorf("redelivery during the handler: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Once handled, the event is held for the window, not the lease
+	clock = clock.Add(30 * time.Minute)
+	if err := dedup.Process(ctx, e, ok); !errors.Is(err, ErrDuplicateEvent) {
+		t.Errorf("redelivery after handling: %v", err)
+	}
+
+	// A consumer that stops mid-handler leaves a claim that expires with the lease
+	crashed := &AssetUpdateEventData{BaseEvent: BaseEvent{ID: "evt-2", Type: AssetUpdateEvent}}
+	func() {
+		defer func() { recover() }()
+		dedup.Process(ctx, crashed, func(context.Context) error { panic("consumer stopped") })
+	}()
+	if err := dedup.Process(ctx, crashed, ok); !errors.Is(err, ErrDuplicateEvent) {
+		t.Errorf("redelivery within the lease: %v", err)
+	}
+	clock = clock.Add(time.Minute)
+	calls := 0
+	if err := dedup.Process(ctx, crashed, func(context.Context) error { calls++; return nil }); err != nil || calls != 1 {
+		t.Errorf("redelivery after the lease: %v, handler ran %d times", err, calls)
+	}
+}
+
+func TestMemoryDedupStoreEvictsLeastRecentlySeen(t *testing.T) {
+	store := NewMemoryDedupStore(2)
+	ctx := context.Background()
+	now := time.Now()
+	later := now.Add(time.Hour)
+
+	store.MarkSeen(ctx, "a", now, later)
+	store.MarkSeen(ctx, "b", now, later)
+	store.MarkSeen(ctx, "a", now, later) // a is now the most recent
+	store.MarkSeen(ctx, "c", now, later) // evicts b
+
+	if store.Len() != 2 {
+		t.Fatalf("len = %d, want 2", store.Len())
+	}
+	if seen, _ := store.MarkSeen(ctx, "a", now, later); !seen {
+		t.Error("a should still be held")
+	}
+	if seen, _ := store.MarkSeen(ctx, "b", now, later); seen {
+		t.Error("b should have been evicted")
+	}
+}
+
+func TestMemoryDedupStoreConcurrentMarkSeen(t *testing.T) {
+	store := NewMemoryDedupStore(100)
+	ctx := context.Background()
+	now := time.Now()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	firsts := map[string]int{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := fmt.Sprintf("evt-%d", i%5)
+			if seen, _ := store.MarkSeen(ctx, id, now, now.Add(time.Hour)); !seen {
+				mu.Lock()
+				firsts[id]++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	for id, n := range firsts {
+		if n != 1 {
+			t.Errorf("%s reported unseen %d times", id, n)
+		}
+	}
+	if len(firsts) != 5 {
+		t.Errorf("%d IDs reported unseen, want 5", len(firsts))
+	}
+}
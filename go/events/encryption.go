@@ -0,0 +1,421 @@
+package events
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// Field-level encryption of sensitive payload fields.
+//
+// Fields tagged sensitivity:"sensitive" (vitals, and the health data of models embedded
+// in a payload) can be hidden from consumers that do not hold the key. A FieldEncryptor
+// moves them out of the payload into one AES-256-GCM ciphertext under a fresh data key,
+// wraps the data key with a KeyProvider and records both in BaseEvent.Encryption:
+//
+//	enc := events.NewFieldEncryptor(keys)
+//	err := enc.Encrypt(ctx, vitals)         // producer, before EncodeMessage
+//	err := enc.Decrypt(ctx, payload)        // authorized consumer, after DecodeMessage
+//
+// The encrypted fields are zeroed, and sensitive fields are omitempty, so they are left
+// out of the encoded payload. Every consumer still decodes the event; those without the
+// key see the ciphertext and no value for the fields it lists. The key ID travels in the
+// event, so old events stay readable after a rotation; Rewrap moves a stored event to
+// the current key without decrypting its fields.
+
+var (
+	// ErrUnknownKey is returned by a KeyProvider for a key ID it does not hold
+	ErrUnknownKey = errors.New("events: unknown encryption key")
+	// ErrDecrypt is returned when encrypted fields fail authentication
+	ErrDecrypt = errors.New("events: cannot decrypt fields")
+	// ErrAlreadyEncrypted is returned by Encrypt for an event that carries Encryption
+	ErrAlreadyEncrypted = errors.New("events: event already encrypted")
+)
+
+// dataKeySize is the size of an AES-256 key
+const dataKeySize = 32
+
+// FieldEncryption describes the encrypted sensitive fields of an event
+type FieldEncryption struct {
+	KeyID      string   `json:"keyId"`      // key that wrapped DataKey
+	DataKey    []byte   `json:"dataKey"`    // wrapped AES-256 data key
+	Fields     []string `json:"fields"`     // JSON paths of the encrypted fields, e.g. "pulseRate"
+	Ciphertext []byte   `json:"ciphertext"` // nonce followed by the sealed fields
+}
+
+// Has reports whether the field at a JSON path is encrypted
+func (f *FieldEncryption) Has(path string) bool {
+	return f != nil && contains(f.Fields, path)
+}
+
+// KeyProvider wraps data keys with key-encryption keys it holds, e.g. in a KMS
+type KeyProvider interface {
+	// WrapKey encrypts a data key with the current key and returns that key's ID
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error)
+	// UnwrapKey decrypts a data key; it returns ErrUnknownKey for a key it does not hold
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// FieldEncryptor encrypts and decrypts the sensitive fields of payloads
+type FieldEncryptor struct {
+	keys KeyProvider
+}
+
+// NewFieldEncryptor returns an encryptor wrapping data keys with keys
+func NewFieldEncryptor(keys KeyProvider) *FieldEncryptor {
+	return &FieldEncryptor{keys: keys}
+}
+
+// Encrypt moves the non-zero sensitive fields of a payload into e.EventBase().Encryption
+// and zeroes them, which omits them from the encoded payload. A payload without such
+// fields is left unchanged.
+func (c *FieldEncryptor) Encrypt(ctx context.Context, e Event) error {
+	base := e.EventBase()
+	if base.Encryption != nil {
+		return fmt.Errorf("%w: %s", ErrAlreadyEncrypted, base.ID)
+	}
+	fields := map[string]reflect.Value{}
+	sensitiveFields(reflect.ValueOf(e), "", fields)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	plain := make(map[string]json.RawMessage, len(fields))
+	paths := make([]string, 0, len(fields))
+	for path, v := range fields {
+		raw, err := json.Marshal(v.Interface())
+		if err != nil {
+			return fmt.Errorf("events: encrypt %s: %w", path, err)
+		}
+		plain[path] = raw
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	plaintext, err := json.Marshal(plain)
+	if err != nil {
+		return err
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return fmt.Errorf("events: data key: %w", err)
+	}
+	ciphertext, err := seal(dataKey, plaintext, encryptionAAD(base))
+	if err != nil {
+		return err
+	}
+	keyID, wrapped, err := c.keys.WrapKey(ctx, dataKey)
+	if err != nil {
+		return fmt.Errorf("events: wrap data key: %w", err)
+	}
+
+	for _, v := range fields {
+		v.Set(reflect.Zero(v.Type()))
+	}
+	base.Encryption = &FieldEncryption{KeyID: keyID, DataKey: wrapped, Fields: paths, Ciphertext: ciphertext}
+	return nil
+}
+
+// Decrypt restores the encrypted fields of a payload and clears its Encryption. A payload
+// without Encryption is left unchanged.
+func (c *FieldEncryptor) Decrypt(ctx context.Context, e Event) error {
+	base := e.EventBase()
+	enc := base.Encryption
+	if enc == nil {
+		return nil
+	}
+	dataKey, err := c.keys.UnwrapKey(ctx, enc.KeyID, enc.DataKey)
+	if err != nil {
+		return fmt.Errorf("events: unwrap data key %s: %w", enc.KeyID, err)
+	}
+	plaintext, err := open(dataKey, enc.Ciphertext, encryptionAAD(base))
+	if err != nil {
+		return err
+	}
+	var plain map[string]json.RawMessage
+	if err := json.Unmarshal(plaintext, &plain); err != nil {
+		return fmt.Errorf("%w: %v", ErrDecrypt, err)
+	}
+	if len(plain) != len(enc.Fields) {
+		return fmt.Errorf("%w: fields do not match the ciphertext", ErrDecrypt)
+	}
+
+	v := reflect.ValueOf(e)
+	for _, path := range enc.Fields {
+		raw, ok := plain[path]
+		if !ok {
+			return fmt.Errorf("%w: %s is not in the ciphertext", ErrDecrypt, path)
+		}
+		field, err := fieldAt(v, strings.Split(path, "."))
+		if err != nil {
+			return fmt.Errorf("events: decrypt %s: %w", path, err)
+		}
+		if err := json.Unmarshal(raw, field.Addr().Interface()); err != nil {
+			return fmt.Errorf("events: decrypt %s: %w", path, err)
+		}
+	}
+	base.Encryption = nil
+	return nil
+}
+
+// Rewrap wraps the data key of an encrypted payload with the provider's current key,
+// leaving the ciphertext as it is. Use it to move stored events off a retired key.
+func (c *FieldEncryptor) Rewrap(ctx context.Context, e Event) error {
+	enc := e.EventBase().Encryption
+	if enc == nil {
+		return nil
+	}
+	dataKey, err := c.keys.UnwrapKey(ctx, enc.KeyID, enc.DataKey)
+	if err != nil {
+		return fmt.Errorf("events: unwrap data key %s: %w", enc.KeyID, err)
+	}
+	keyID, wrapped, err := c.keys.WrapKey(ctx, dataKey)
+	if err != nil {
+		return fmt.Errorf("events: wrap data key: %w", err)
+	}
+	enc.KeyID, enc.DataKey = keyID, wrapped
+	return nil
+}
+
+// encryptionAAD binds the ciphertext to its event, so it cannot be moved to another one
+func encryptionAAD(base *BaseEvent) []byte {
+	return []byte(base.ID + "\x00" + string(base.Type))
+}
+
+// sensitiveFields collects the non-zero fields tagged sensitive under v, by JSON path.
+// Untyped values such as metadata maps cannot be classified and are not visited.
+func sensitiveFields(v reflect.Value, path string, out map[string]reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			sensitiveFields(v.Elem(), path, out)
+		}
+	case reflect.Slice, reflect.Array:
+		switch v.Type().Elem().Kind() {
+		case reflect.Struct, reflect.Pointer, reflect.Slice, reflect.Array:
+		default:
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			sensitiveFields(v.Index(i), joinPath(path, strconv.Itoa(i)), out)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name, embedded := jsonFieldName(f)
+			switch {
+			case name == "":
+			case embedded:
+				sensitiveFields(v.Field(i), path, out)
+			case models.SensitivityOf(f) == models.SensitivitySensitive:
+				if !v.Field(i).IsZero() {
+					out[joinPath(path, name)] = v.Field(i)
+				}
+			default:
+				sensitiveFields(v.Field(i), joinPath(path, name), out)
+			}
+		}
+	}
+}
+
+// fieldAt returns the settable value at a JSON path collected by sensitiveFields
+func fieldAt(v reflect.Value, path []string) (reflect.Value, error) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	if len(path) == 0 {
+		return v, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		i, err := strconv.Atoi(path[0])
+		if err != nil || i < 0 || i >= v.Len() {
+			return reflect.Value{}, fmt.Errorf("no element %q", path[0])
+		}
+		return fieldAt(v.Index(i), path[1:])
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name, embedded := jsonFieldName(t.Field(i))
+			if embedded {
+				if fv := v.Field(i); fv.Kind() == reflect.Pointer && fv.IsNil() {
+					continue
+				}
+				if field, err := fieldAt(v.Field(i), path); err == nil {
+					return field, nil
+				}
+				continue
+			}
+			if name != "" && name == path[0] {
+				return fieldAt(v.Field(i), path[1:])
+			}
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("no field %q in %s", path[0], v.Type())
+}
+
+// jsonFieldName returns the JSON key of a struct field, "" if it is not encoded, and
+// whether it is an embedded struct whose fields are promoted
+func jsonFieldName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if f.Anonymous && name == "" {
+		t := f.Type
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() == reflect.Struct {
+			return t.Name(), true
+		}
+	}
+	if !f.IsExported() {
+		return "", false
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, false
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// seal encrypts with AES-GCM under a random nonce, which prefixes the result
+func seal(key, plaintext, aad []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("events: nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+// open reverses seal
+func open(key, ciphertext, aad []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("%w: ciphertext too short", ErrDecrypt)
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, aad)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("events: key must be %d bytes, got %d", dataKeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Keyring is a KeyProvider holding AES-256 key-encryption keys in memory, for tests and
+// for key files loaded by filekeys. Data keys are wrapped with AES-GCM.
+type Keyring struct {
+	mu      sync.RWMutex
+	keys    map[string][]byte
+	current string
+}
+
+var _ KeyProvider = (*Keyring)(nil)
+
+// NewKeyring returns a keyring that wraps with the key current
+func NewKeyring(current string, keys map[string][]byte) (*Keyring, error) {
+	r := &Keyring{keys: map[string][]byte{}}
+	for id, key := range keys {
+		if err := r.Add(id, key); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.Rotate(current); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Add adds a key that can unwrap data keys; it does not become the current key
+func (r *Keyring) Add(keyID string, key []byte) error {
+	if keyID == "" {
+		return errors.New("events: key ID must not be empty")
+	}
+	if len(key) != dataKeySize {
+		return fmt.Errorf("events: key %s must be %d bytes, got %d", keyID, dataKeySize, len(key))
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys[keyID] = append([]byte(nil), key...)
+	return nil
+}
+
+// Rotate makes a key that was added the one new data keys are wrapped with
+func (r *Keyring) Rotate(keyID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.keys[keyID]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownKey, keyID)
+	}
+	r.current = keyID
+	return nil
+}
+
+// Current returns the ID of the key new data keys are wrapped with
+func (r *Keyring) Current() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.current
+}
+
+// WrapKey implements KeyProvider
+func (r *Keyring) WrapKey(_ context.Context, dataKey []byte) (string, []byte, error) {
+	r.mu.RLock()
+	keyID, key := r.current, r.keys[r.current]
+	r.mu.RUnlock()
+	wrapped, err := seal(key, dataKey, []byte(keyID))
+	return keyID, wrapped, err
+}
+
+// UnwrapKey implements KeyProvider
+func (r *Keyring) UnwrapKey(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	r.mu.RLock()
+	key, ok := r.keys[keyID]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, keyID)
+	}
+	return open(key, wrapped, []byte(keyID))
+}
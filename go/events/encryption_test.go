@@ -0,0 +1,269 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// testKeyring returns a keyring holding a fixed key per ID; the last ID is current
+func testKeyring(t *testing.T, ids ...string) *Keyring {
+	keys := map[string][]byte{}
+	for i, id := range ids {
+		keys[id] = bytes.Repeat([]byte{byte(i + 1)}, dataKeySize)
+	}
+	r, err := NewKeyring(ids[len(ids)-1], keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func vitalsSample() *VitalsUpdateEventData {
+	SetSource("encryption-test")
+	return NewVitalsUpdate(VitalsUpdateEventData{
+		PersonnelID: "p-7", PersonnelName: "Ada", PulseRate: 131, OxygenLevel: 87, Temperature: 38.4,
+		IsAlert: true, AlertReason: "hypoxia",
+	})
+}
+
+func TestEncryptVitals(t *testing.T) {
+	ctx := context.Background()
+	enc := NewFieldEncryptor(testKeyring(t, "k1"))
+	vitals := vitalsSample()
+	want := *vitals
+
+	if err := enc.Encrypt(ctx, vitals); err != nil {
+		t.Fatal(err)
+	}
+	if vitals.PulseRate != 0 || vitals.OxygenLevel != 0 || vitals.Temperature != 0 || vitals.AlertReason != "" || vitals.PersonnelName != "Ada" {
+		t.Errorf("encrypted payload %+v", vitals)
+	}
+	if got := vitals.Encryption.Fields; !reflect.DeepEqual(got, []string{"alertReason", "oxygenLevel", "pulseRate", "temperature"}) {
+		t.Errorf("encrypted fields %v", got)
+	}
+	if vitals.Encryption.KeyID != "k1" {
+		t.Errorf("key ID %q", vitals.Encryption.KeyID)
+	}
+	if err := enc.Encrypt(ctx, vitals); !errors.Is(err, ErrAlreadyEncrypted) {
+		t.Errorf("second Encrypt: %v", err)
+	}
+
+	msg, err := EncodeMessage(vitals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Consumers without the key see no value rather than a zero
+	for _, field := range []string{`"pulseRate":`, `"oxygenLevel":`, `"temperature":`, `"alertReason":`, "hypoxia"} {
+		if strings.Contains(string(msg.Value), field) {
+			t.Errorf("record holds %s: %s", field, msg.Value)
+		}
+	}
+
+	// Every consumer decodes and validates the record; only key holders decrypt it
+	decoded, err := DecodeMessage(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := decoded.(*VitalsUpdateEventData)
+	if err := NewFieldEncryptor(testKeyring(t, "other")).Decrypt(ctx, got); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Decrypt without the key: %v", err)
+	}
+	if err := enc.Decrypt(ctx, got); err != nil {
+		t.Fatal(err)
+	}
+	want.Timestamp = got.Timestamp
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("decrypted\n got: %+v\nwant: %+v", *got, want)
+	}
+}
+
+// TestEncryptedAlertValidates checks consumers without the key can validate an alert
+// whose reason is encrypted
+func TestEncryptedAlertValidates(t *testing.T) {
+	vitals := vitalsSample()
+	if err := NewFieldEncryptor(testKeyring(t, "k1")).Encrypt(context.Background(), vitals); err != nil {
+		t.Fatal(err)
+	}
+	if err := vitals.Validate(); err != nil {
+		t.Errorf("encrypted alert: %v", err)
+	}
+	msg, err := EncodeMessage(vitals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeMessage(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Validate(decoded); err != nil {
+		t.Errorf("decoded encrypted alert: %v", err)
+	}
+}
+
+func TestEncryptedFieldsAreOmitted(t *testing.T) {
+	enc := NewFieldEncryptor(testKeyring(t, "k1"))
+	for _, eventType := range RegisteredTypes() {
+		t.Run(string(eventType), func(t *testing.T) {
+			e := fullPayload(t, eventType)
+			if err := enc.Encrypt(context.Background(), e); err != nil {
+				t.Fatal(err)
+			}
+			if e.EventBase().Encryption == nil {
+				return // no sensitive fields
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var doc any
+			if err := json.Unmarshal(data, &doc); err != nil {
+				t.Fatal(err)
+			}
+			for _, path := range e.EventBase().Encryption.Fields {
+				if v, ok := jsonAt(doc, strings.Split(path, ".")); ok {
+					t.Errorf("encrypted %s encoded as %v", path, v)
+				}
+			}
+		})
+	}
+}
+
+// jsonAt returns the value at a JSON path of a decoded document
+func jsonAt(doc any, path []string) (any, bool) {
+	for _, key := range path {
+		switch node := doc.(type) {
+		case map[string]any:
+			v, ok := node[key]
+			if !ok {
+				return nil, false
+			}
+			doc = v
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i >= len(node) {
+				return nil, false
+			}
+			doc = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+func TestEncryptionSurvivesProtobuf(t *testing.T) {
+	ctx := context.Background()
+	enc := NewFieldEncryptor(testKeyring(t, "k1"))
+	vitals := vitalsSample()
+	if err := enc.Encrypt(ctx, vitals); err != nil {
+		t.Fatal(err)
+	}
+	m, err := ToProto(vitals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	back, err := FromProto(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back.EventBase().Encryption, vitals.Encryption) {
+		t.Errorf("encryption after Protobuf %+v", back.EventBase().Encryption)
+	}
+	if err := enc.Decrypt(ctx, back); err != nil || back.(*VitalsUpdateEventData).PulseRate != 131 {
+		t.Errorf("Decrypt() = %v", err)
+	}
+}
+
+func TestDecryptRejectsTampering(t *testing.T) {
+	ctx := context.Background()
+	enc := NewFieldEncryptor(testKeyring(t, "k1"))
+	vitals := vitalsSample()
+	if err := enc.Encrypt(ctx, vitals); err != nil {
+		t.Fatal(err)
+	}
+
+	// Ciphertext copied onto another event
+	other := vitalsSample()
+	copied := *vitals.Encryption
+	other.Encryption = &copied
+	if err := enc.Decrypt(ctx, other); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("moved ciphertext: %v", err)
+	}
+
+	// Field list edited in transit
+	vitals.Encryption.Fields = vitals.Encryption.Fields[:1]
+	if err := enc.Decrypt(ctx, vitals); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("edited field list: %v", err)
+	}
+}
+
+// assetRoster is a payload carrying full asset models, whose vitals must be encrypted too
+type assetRoster struct {
+	BaseEvent
+	Assets []models.Asset `json:"assets"`
+	Leader *models.Asset  `json:"leader,omitempty"`
+}
+
+func TestEncryptNestedModels(t *testing.T) {
+	ctx := context.Background()
+	enc := NewFieldEncryptor(testKeyring(t, "k1"))
+	roster := &assetRoster{
+		BaseEvent: BaseEvent{ID: "evt-roster", Type: AssetUpdateEvent},
+		Assets:    []models.Asset{{ID: "a1", PulseRate: 80, OxygenLevel: 97}, {ID: "a2"}},
+		Leader:    &models.Asset{ID: "a3", PulseRate: 66},
+	}
+	want := []string{"assets.0.oxygenLevel", "assets.0.pulseRate", "leader.pulseRate"}
+
+	if err := enc.Encrypt(ctx, roster); err != nil {
+		t.Fatal(err)
+	}
+	if got := roster.Encryption.Fields; !reflect.DeepEqual(got, want) {
+		t.Errorf("encrypted fields %v, want %v", got, want)
+	}
+	if roster.Assets[0].PulseRate != 0 || roster.Leader.PulseRate != 0 {
+		t.Errorf("asset vitals left in the payload: %+v", roster)
+	}
+	if err := enc.Decrypt(ctx, roster); err != nil {
+		t.Fatal(err)
+	}
+	if roster.Assets[0].PulseRate != 80 || roster.Assets[0].OxygenLevel != 97 || roster.Leader.PulseRate != 66 {
+		t.Errorf("decrypted roster %+v", roster)
+	}
+}
+
+func TestEncryptWithoutSensitiveFields(t *testing.T) {
+	SetSource("encryption-test")
+	chat := NewMissionChatMessage(MissionChatMessageEventData{MissionID: "m1", SenderName: "Ada", Content: "On my way"})
+	if err := NewFieldEncryptor(testKeyring(t, "k1")).Encrypt(context.Background(), chat); err != nil || chat.Encryption != nil {
+		t.Errorf("Encrypt() = %v, encryption %+v", err, chat.Encryption)
+	}
+}
+
+func TestKeyring(t *testing.T) {
+	ctx := context.Background()
+	r := testKeyring(t, "k1", "k2")
+	keyID, wrapped, err := r.WrapKey(ctx, bytes.Repeat([]byte{9}, dataKeySize))
+	if err != nil || keyID != "k2" {
+		t.Fatalf("WrapKey() = %q, %v", keyID, err)
+	}
+	if _, err := r.UnwrapKey(ctx, "k1", wrapped); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("unwrap with the wrong key: %v", err)
+	}
+	if err := r.Rotate("k3"); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Rotate to an unknown key: %v", err)
+	}
+	if err := r.Add("k3", []byte("short")); err == nil {
+		t.Error("Add accepted a short key")
+	}
+	if _, err := NewKeyring("missing", nil); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("NewKeyring without its current key: %v", err)
+	}
+}
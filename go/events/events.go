@@ -1,5 +1,7 @@
 // Event Schema Version: 1.0
 // Breaking changes require major version bump
+// Each event carries its version in BaseEvent.SchemaVersion; see upcast.go
+// Events derived from another event carry its correlation and causation IDs; see trace.go
 //
 // Event ownership:
 // - AssetUpdateEvent → dispatch-asset-service
@@ -7,6 +9,7 @@
 // - VideoUploadEvent → video-processing-service
 // - TacticalCommandCreated → mission-command-service
 // - FireAlertCreatedEvent → backend (temporary, to be moved)
+// - FireRisk*Event → backend (fire event processing), see fire_event_schema.go
 //
 // Consumers: All services (event-driven architecture)
 
@@ -45,12 +48,73 @@ const (
 	MissionTypingIndicatorEvent  EventType = "mission_typing_indicator"
 )
 
+// ValidEventTypes returns all event types defined by the contracts
+func ValidEventTypes() []EventType {
+	return []EventType{
+		AssetUpdateEvent,
+		AssetRecallEvent,
+		EmergencyNotification,
+		ChatMessageEvent,
+		SystemStatusEvent,
+		LocationUpdateEvent,
+		VitalsUpdateEvent,
+		VideoUploadEvent,
+		VideoProcessingEvent,
+		FrameExtractionEvent,
+		FrameUploadCompleteEvent,
+		AIAnalysisEvent,
+		EventAnalysisEvent,
+		SuggestionCreated,
+		MissionCreated,
+		AIMissionSuggestion,
+		TacticalCommandCreated,
+		TacticalCommandResponse,
+		TacticalCommandStatusChanged,
+		TacticalSuggestionCreated,
+		FireAlertCreatedEvent,
+		FireRiskDetectedEvent,
+		FireRiskUpdatedEvent,
+		FireRiskClearedEvent,
+		MissionChatMessageEvent,
+		MissionTypingIndicatorEvent,
+	}
+}
+
+// IsValidEventType checks if an event type is defined by the contracts
+func IsValidEventType(eventType EventType) bool {
+	for _, t := range ValidEventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
 // BaseEvent represents the common structure for all events
 type BaseEvent struct {
 	ID        string    `json:"id"`
 	Type      EventType `json:"type"`
 	Timestamp time.Time `json:"timestamp"`
 	Source    string    `json:"source"`
+
+	// SchemaVersion of the payload; empty means DefaultSchemaVersion
+	SchemaVersion string `json:"schemaVersion,omitempty"`
+
+	// Causality and tracing, see trace.go
+	CorrelationID string `json:"correlationId,omitempty"` // ID of the root event of the incident
+	CausationID   string `json:"causationId,omitempty"`   // ID of the event that caused this one
+	TraceParent   string `json:"traceparent,omitempty"`   // W3C Trace Context traceparent
+	TraceState    string `json:"tracestate,omitempty"`    // W3C Trace Context tracestate
+
+	// Per-aggregate ordering, see sequence.go
+	AggregateID string `json:"aggregateId,omitempty"` // e.g. the command ID of tactical command events
+	Sequence    int64  `json:"sequence,omitempty"`    // position in the aggregate's event stream, from 1
+
+	// TenantID of the organisation the event belongs to, see tenant.go
+	TenantID string `json:"tenantId,omitempty"`
+
+	// Encryption holds the encrypted sensitive fields, see encryption.go
+	Encryption *FieldEncryption `json:"encryption,omitempty"`
 }
 
 // AssetUpdateEventData represents asset status changes
@@ -65,6 +129,22 @@ type AssetUpdateEventData struct {
 	Metadata  map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// AssetRecallEventData represents an operator recalling a dispatched asset to base
+type AssetRecallEventData struct {
+	BaseEvent
+	AssetID        string        `json:"assetId"`
+	AssetName      string        `json:"assetName"`
+	MissionID      string        `json:"missionId,omitempty"` // Mission the asset is pulled from
+	Reason         string        `json:"reason"`              // models.AssetRecallReason
+	Notes          string        `json:"notes,omitempty"`
+	ReturnBaseID   string        `json:"returnBaseId"`
+	ReturnBaseName string        `json:"returnBaseName,omitempty"`
+	ReturnBy       time.Time     `json:"returnBy"` // Deadline for arriving at base
+	RecalledBy     string        `json:"recalledBy"`
+	RecalledByName string        `json:"recalledByName" sensitivity:"pii"`
+	Location       *LocationData `json:"location,omitempty"` // Asset position when recalled
+}
+
 // EmergencyNotificationEventData represents emergency alerts
 type EmergencyNotificationEventData struct {
 	BaseEvent
@@ -106,12 +186,12 @@ type LocationUpdateEventData struct {
 type VitalsUpdateEventData struct {
 	BaseEvent
 	PersonnelID   string  `json:"personnelId"`
-	PersonnelName string  `json:"personnelName"`
-	PulseRate     int     `json:"pulseRate"`
-	OxygenLevel   int     `json:"oxygenLevel"`
-	Temperature   float64 `json:"temperature,omitempty"`
+	PersonnelName string  `json:"personnelName" sensitivity:"pii"`
+	PulseRate     int     `json:"pulseRate,omitempty" sensitivity:"sensitive"`
+	OxygenLevel   int     `json:"oxygenLevel,omitempty" sensitivity:"sensitive"`
+	Temperature   float64 `json:"temperature,omitempty" sensitivity:"sensitive"`
 	IsAlert       bool    `json:"isAlert"`
-	AlertReason   string  `json:"alertReason,omitempty"`
+	AlertReason   string  `json:"alertReason,omitempty" sensitivity:"sensitive"`
 }
 
 // SystemStatusEventData represents system-wide status changes
@@ -332,7 +412,7 @@ type TacticalCommandResponseEventData struct {
 	Decision        string `json:"decision"` // "accepted" or "rejected"
 	Notes           string `json:"notes,omitempty"`
 	RespondedBy     string `json:"respondedBy"`
-	RespondedByName string `json:"respondedByName"`
+	RespondedByName string `json:"respondedByName" sensitivity:"pii"`
 	NewStatus       string `json:"newStatus"`
 }
 
@@ -345,7 +425,7 @@ type TacticalCommandStatusEventData struct {
 	OldStatus     string `json:"oldStatus"`
 	NewStatus     string `json:"newStatus"`
 	UpdatedBy     string `json:"updatedBy"`
-	UpdatedByName string `json:"updatedByName"`
+	UpdatedByName string `json:"updatedByName" sensitivity:"pii"`
 	Notes         string `json:"notes,omitempty"`
 }
 
@@ -372,7 +452,7 @@ type MissionChatMessageEventData struct {
 	MissionID  string `json:"missionId"`
 	MessageID  string `json:"messageId"`
 	SenderID   string `json:"senderId"`
-	SenderName string `json:"senderName"`
+	SenderName string `json:"senderName" sensitivity:"pii"`
 	SenderRole string `json:"senderRole"` // "operator" | "field_agent"
 	Content    string `json:"content"`
 }
@@ -380,7 +460,7 @@ type MissionChatMessageEventData struct {
 // MissionTypingUser represents a user who is typing
 type MissionTypingUser struct {
 	UserID   string `json:"userId"`
-	UserName string `json:"userName"`
+	UserName string `json:"userName" sensitivity:"pii"`
 }
 
 // MissionTypingIndicatorEventData represents typing status in a mission chat
@@ -390,12 +470,14 @@ type MissionTypingIndicatorEventData struct {
 	TypingUsers []MissionTypingUser `json:"typingUsers"`
 }
 
-// WebSocketMessage represents messages sent to frontend clients
+// WebSocketMessage is the "event" frame of the WebSocket protocol: an event pushed to
+// frontend clients. See websocket.go for the other frames.
 type WebSocketMessage struct {
-	Type      string      `json:"type"`
-	Event     EventType   `json:"event"`
-	Data      interface{} `json:"data"`
-	Timestamp time.Time   `json:"timestamp"`
-	ClientID  string      `json:"clientId,omitempty"`
+	Type           string      `json:"type"` // always "event"
+	Event          EventType   `json:"event"`
+	Data           interface{} `json:"data"`
+	Timestamp      time.Time   `json:"timestamp"`
+	ClientID       string      `json:"clientId,omitempty"`
+	SubscriptionID string      `json:"subscriptionId,omitempty"` // subscription the event matched
+	Offset         int64       `json:"offset,omitempty"`         // hub position, for ack and resume
 }
-
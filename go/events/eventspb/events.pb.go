@@ -0,0 +1,4474 @@
+// Owner: phlx-contracts
+// Consumers: All services publishing to a Protobuf topic, see go/events/codec.go
+//
+// Protobuf mirror of go/events. Every payload message is named after its Go
+// struct and carries the BaseEvent fields in base_event. Field names are the
+// snake_case form of the JSON keys, so ToProto/FromProto can map them by name.
+// Field numbers are part of the wire contract: never reuse or renumber them.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: phlx/events/v1/events.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BaseEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	SchemaVersion string                 `protobuf:"bytes,5,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	CorrelationId string                 `protobuf:"bytes,6,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	CausationId   string                 `protobuf:"bytes,7,opt,name=causation_id,json=causationId,proto3" json:"causation_id,omitempty"`
+	Traceparent   string                 `protobuf:"bytes,8,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
+	Tracestate    string                 `protobuf:"bytes,9,opt,name=tracestate,proto3" json:"tracestate,omitempty"`
+	AggregateId   string                 `protobuf:"bytes,10,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`
+	Sequence      int64                  `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	TenantId      string                 `protobuf:"bytes,12,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Encryption    *FieldEncryption       `protobuf:"bytes,13,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseEvent) Reset() {
+	*x = BaseEvent{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseEvent) ProtoMessage() {}
+
+func (x *BaseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseEvent.ProtoReflect.Descriptor instead.
+func (*BaseEvent) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BaseEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BaseEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BaseEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *BaseEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *BaseEvent) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *BaseEvent) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *BaseEvent) GetCausationId() string {
+	if x != nil {
+		return x.CausationId
+	}
+	return ""
+}
+
+func (x *BaseEvent) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
+func (x *BaseEvent) GetTracestate() string {
+	if x != nil {
+		return x.Tracestate
+	}
+	return ""
+}
+
+func (x *BaseEvent) GetAggregateId() string {
+	if x != nil {
+		return x.AggregateId
+	}
+	return ""
+}
+
+func (x *BaseEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *BaseEvent) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *BaseEvent) GetEncryption() *FieldEncryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return nil
+}
+
+type FieldEncryption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	DataKey       []byte                 `protobuf:"bytes,2,opt,name=data_key,json=dataKey,proto3" json:"data_key,omitempty"`
+	Fields        []string               `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Ciphertext    []byte                 `protobuf:"bytes,4,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldEncryption) Reset() {
+	*x = FieldEncryption{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldEncryption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldEncryption) ProtoMessage() {}
+
+func (x *FieldEncryption) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldEncryption.ProtoReflect.Descriptor instead.
+func (*FieldEncryption) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FieldEncryption) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *FieldEncryption) GetDataKey() []byte {
+	if x != nil {
+		return x.DataKey
+	}
+	return nil
+}
+
+func (x *FieldEncryption) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *FieldEncryption) GetCiphertext() []byte {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return nil
+}
+
+type LocationData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Altitude      float64                `protobuf:"fixed64,3,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Area          string                 `protobuf:"bytes,5,opt,name=area,proto3" json:"area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationData) Reset() {
+	*x = LocationData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationData) ProtoMessage() {}
+
+func (x *LocationData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationData.ProtoReflect.Descriptor instead.
+func (*LocationData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LocationData) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *LocationData) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *LocationData) GetAltitude() float64 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+func (x *LocationData) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LocationData) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+type BoundingBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             int64                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             int64                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	Width         int64                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int64                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BoundingBox) GetX() int64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetY() int64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type AssetUpdateEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	AssetId       string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	AssetName     string                 `protobuf:"bytes,3,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	AssetType     string                 `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	OldStatus     string                 `protobuf:"bytes,5,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus     string                 `protobuf:"bytes,6,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	Location      *LocationData          `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetUpdateEventData) Reset() {
+	*x = AssetUpdateEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetUpdateEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetUpdateEventData) ProtoMessage() {}
+
+func (x *AssetUpdateEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetUpdateEventData.ProtoReflect.Descriptor instead.
+func (*AssetUpdateEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AssetUpdateEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *AssetUpdateEventData) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *AssetUpdateEventData) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *AssetUpdateEventData) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *AssetUpdateEventData) GetOldStatus() string {
+	if x != nil {
+		return x.OldStatus
+	}
+	return ""
+}
+
+func (x *AssetUpdateEventData) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *AssetUpdateEventData) GetLocation() *LocationData {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *AssetUpdateEventData) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type AssetRecallEventData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent      *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	AssetId        string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	AssetName      string                 `protobuf:"bytes,3,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	MissionId      string                 `protobuf:"bytes,4,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Notes          string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	ReturnBaseId   string                 `protobuf:"bytes,7,opt,name=return_base_id,json=returnBaseId,proto3" json:"return_base_id,omitempty"`
+	ReturnBaseName string                 `protobuf:"bytes,8,opt,name=return_base_name,json=returnBaseName,proto3" json:"return_base_name,omitempty"`
+	ReturnBy       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=return_by,json=returnBy,proto3" json:"return_by,omitempty"`
+	RecalledBy     string                 `protobuf:"bytes,10,opt,name=recalled_by,json=recalledBy,proto3" json:"recalled_by,omitempty"`
+	RecalledByName string                 `protobuf:"bytes,11,opt,name=recalled_by_name,json=recalledByName,proto3" json:"recalled_by_name,omitempty"`
+	Location       *LocationData          `protobuf:"bytes,12,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssetRecallEventData) Reset() {
+	*x = AssetRecallEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetRecallEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetRecallEventData) ProtoMessage() {}
+
+func (x *AssetRecallEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetRecallEventData.ProtoReflect.Descriptor instead.
+func (*AssetRecallEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AssetRecallEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *AssetRecallEventData) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *AssetRecallEventData) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *AssetRecallEventData) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *AssetRecallEventData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AssetRecallEventData) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *AssetRecallEventData) GetReturnBaseId() string {
+	if x != nil {
+		return x.ReturnBaseId
+	}
+	return ""
+}
+
+func (x *AssetRecallEventData) GetReturnBaseName() string {
+	if x != nil {
+		return x.ReturnBaseName
+	}
+	return ""
+}
+
+func (x *AssetRecallEventData) GetReturnBy() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReturnBy
+	}
+	return nil
+}
+
+func (x *AssetRecallEventData) GetRecalledBy() string {
+	if x != nil {
+		return x.RecalledBy
+	}
+	return ""
+}
+
+func (x *AssetRecallEventData) GetRecalledByName() string {
+	if x != nil {
+		return x.RecalledByName
+	}
+	return ""
+}
+
+func (x *AssetRecallEventData) GetLocation() *LocationData {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type EmergencyNotificationEventData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent      *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	NotificationId string                 `protobuf:"bytes,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Severity       string                 `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity,omitempty"`
+	Area           string                 `protobuf:"bytes,6,opt,name=area,proto3" json:"area,omitempty"`
+	RecipientCount int64                  `protobuf:"varint,7,opt,name=recipient_count,json=recipientCount,proto3" json:"recipient_count,omitempty"`
+	Coordinates    *LocationData          `protobuf:"bytes,8,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Acknowledged   bool                   `protobuf:"varint,9,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	AcknowledgedBy string                 `protobuf:"bytes,10,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EmergencyNotificationEventData) Reset() {
+	*x = EmergencyNotificationEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergencyNotificationEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyNotificationEventData) ProtoMessage() {}
+
+func (x *EmergencyNotificationEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergencyNotificationEventData.ProtoReflect.Descriptor instead.
+func (*EmergencyNotificationEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EmergencyNotificationEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *EmergencyNotificationEventData) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *EmergencyNotificationEventData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EmergencyNotificationEventData) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EmergencyNotificationEventData) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *EmergencyNotificationEventData) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *EmergencyNotificationEventData) GetRecipientCount() int64 {
+	if x != nil {
+		return x.RecipientCount
+	}
+	return 0
+}
+
+func (x *EmergencyNotificationEventData) GetCoordinates() *LocationData {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *EmergencyNotificationEventData) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+func (x *EmergencyNotificationEventData) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+func (x *EmergencyNotificationEventData) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+type ChatMessageEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Sender        string                 `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	SessionId     string                 `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Command       string                 `protobuf:"bytes,6,opt,name=command,proto3" json:"command,omitempty"`
+	Response      string                 `protobuf:"bytes,7,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessageEventData) Reset() {
+	*x = ChatMessageEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessageEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessageEventData) ProtoMessage() {}
+
+func (x *ChatMessageEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessageEventData.ProtoReflect.Descriptor instead.
+func (*ChatMessageEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChatMessageEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *ChatMessageEventData) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChatMessageEventData) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessageEventData) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ChatMessageEventData) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ChatMessageEventData) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ChatMessageEventData) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type LocationUpdateEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	AssetId       string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	AssetName     string                 `protobuf:"bytes,3,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	Location      *LocationData          `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Speed         float64                `protobuf:"fixed64,5,opt,name=speed,proto3" json:"speed,omitempty"`
+	Heading       float64                `protobuf:"fixed64,6,opt,name=heading,proto3" json:"heading,omitempty"`
+	Altitude      float64                `protobuf:"fixed64,7,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationUpdateEventData) Reset() {
+	*x = LocationUpdateEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationUpdateEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationUpdateEventData) ProtoMessage() {}
+
+func (x *LocationUpdateEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationUpdateEventData.ProtoReflect.Descriptor instead.
+func (*LocationUpdateEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LocationUpdateEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *LocationUpdateEventData) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *LocationUpdateEventData) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *LocationUpdateEventData) GetLocation() *LocationData {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *LocationUpdateEventData) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *LocationUpdateEventData) GetHeading() float64 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *LocationUpdateEventData) GetAltitude() float64 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+type VitalsUpdateEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	PersonnelId   string                 `protobuf:"bytes,2,opt,name=personnel_id,json=personnelId,proto3" json:"personnel_id,omitempty"`
+	PersonnelName string                 `protobuf:"bytes,3,opt,name=personnel_name,json=personnelName,proto3" json:"personnel_name,omitempty"`
+	PulseRate     int64                  `protobuf:"varint,4,opt,name=pulse_rate,json=pulseRate,proto3" json:"pulse_rate,omitempty"`
+	OxygenLevel   int64                  `protobuf:"varint,5,opt,name=oxygen_level,json=oxygenLevel,proto3" json:"oxygen_level,omitempty"`
+	Temperature   float64                `protobuf:"fixed64,6,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	IsAlert       bool                   `protobuf:"varint,7,opt,name=is_alert,json=isAlert,proto3" json:"is_alert,omitempty"`
+	AlertReason   string                 `protobuf:"bytes,8,opt,name=alert_reason,json=alertReason,proto3" json:"alert_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VitalsUpdateEventData) Reset() {
+	*x = VitalsUpdateEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VitalsUpdateEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VitalsUpdateEventData) ProtoMessage() {}
+
+func (x *VitalsUpdateEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VitalsUpdateEventData.ProtoReflect.Descriptor instead.
+func (*VitalsUpdateEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VitalsUpdateEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *VitalsUpdateEventData) GetPersonnelId() string {
+	if x != nil {
+		return x.PersonnelId
+	}
+	return ""
+}
+
+func (x *VitalsUpdateEventData) GetPersonnelName() string {
+	if x != nil {
+		return x.PersonnelName
+	}
+	return ""
+}
+
+func (x *VitalsUpdateEventData) GetPulseRate() int64 {
+	if x != nil {
+		return x.PulseRate
+	}
+	return 0
+}
+
+func (x *VitalsUpdateEventData) GetOxygenLevel() int64 {
+	if x != nil {
+		return x.OxygenLevel
+	}
+	return 0
+}
+
+func (x *VitalsUpdateEventData) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *VitalsUpdateEventData) GetIsAlert() bool {
+	if x != nil {
+		return x.IsAlert
+	}
+	return false
+}
+
+func (x *VitalsUpdateEventData) GetAlertReason() string {
+	if x != nil {
+		return x.AlertReason
+	}
+	return ""
+}
+
+type SystemStatusEventData struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent        *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	Status           string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PreviousStatus   string                 `protobuf:"bytes,3,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	ChangedBy        string                 `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Reason           string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActiveAssets     int64                  `protobuf:"varint,6,opt,name=active_assets,json=activeAssets,proto3" json:"active_assets,omitempty"`
+	DispatchedAssets int64                  `protobuf:"varint,7,opt,name=dispatched_assets,json=dispatchedAssets,proto3" json:"dispatched_assets,omitempty"`
+	Metadata         *structpb.Struct       `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemStatusEventData) Reset() {
+	*x = SystemStatusEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemStatusEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStatusEventData) ProtoMessage() {}
+
+func (x *SystemStatusEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStatusEventData.ProtoReflect.Descriptor instead.
+func (*SystemStatusEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SystemStatusEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *SystemStatusEventData) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SystemStatusEventData) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *SystemStatusEventData) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *SystemStatusEventData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SystemStatusEventData) GetActiveAssets() int64 {
+	if x != nil {
+		return x.ActiveAssets
+	}
+	return 0
+}
+
+func (x *SystemStatusEventData) GetDispatchedAssets() int64 {
+	if x != nil {
+		return x.DispatchedAssets
+	}
+	return 0
+}
+
+func (x *SystemStatusEventData) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type VideoUploadEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	VideoName     string                 `protobuf:"bytes,3,opt,name=video_name,json=videoName,proto3" json:"video_name,omitempty"`
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	Duration      float64                `protobuf:"fixed64,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	FileSize      int64                  `protobuf:"varint,6,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	UploadedBy    string                 `protobuf:"bytes,7,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	GcsPath       string                 `protobuf:"bytes,8,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	CameraId      string                 `protobuf:"bytes,10,opt,name=camera_id,json=cameraId,proto3" json:"camera_id,omitempty"`
+	Location      *LocationData          `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoUploadEventData) Reset() {
+	*x = VideoUploadEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoUploadEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoUploadEventData) ProtoMessage() {}
+
+func (x *VideoUploadEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoUploadEventData.ProtoReflect.Descriptor instead.
+func (*VideoUploadEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VideoUploadEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *VideoUploadEventData) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *VideoUploadEventData) GetVideoName() string {
+	if x != nil {
+		return x.VideoName
+	}
+	return ""
+}
+
+func (x *VideoUploadEventData) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *VideoUploadEventData) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *VideoUploadEventData) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *VideoUploadEventData) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *VideoUploadEventData) GetGcsPath() string {
+	if x != nil {
+		return x.GcsPath
+	}
+	return ""
+}
+
+func (x *VideoUploadEventData) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VideoUploadEventData) GetCameraId() string {
+	if x != nil {
+		return x.CameraId
+	}
+	return ""
+}
+
+func (x *VideoUploadEventData) GetLocation() *LocationData {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type VideoProcessingEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	JobType       string                 `protobuf:"bytes,3,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Progress      float64                `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	ErrorMsg      string                 `protobuf:"bytes,6,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoProcessingEventData) Reset() {
+	*x = VideoProcessingEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoProcessingEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoProcessingEventData) ProtoMessage() {}
+
+func (x *VideoProcessingEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoProcessingEventData.ProtoReflect.Descriptor instead.
+func (*VideoProcessingEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VideoProcessingEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *VideoProcessingEventData) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *VideoProcessingEventData) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *VideoProcessingEventData) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VideoProcessingEventData) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *VideoProcessingEventData) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *VideoProcessingEventData) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *VideoProcessingEventData) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type FrameExtractionEventData struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent   *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	VideoId     string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	FrameId     string                 `protobuf:"bytes,3,opt,name=frame_id,json=frameId,proto3" json:"frame_id,omitempty"`
+	FrameNumber int64                  `protobuf:"varint,4,opt,name=frame_number,json=frameNumber,proto3" json:"frame_number,omitempty"`
+	// Seconds from video start; the event time is base_event.timestamp
+	Timestamp     float64       `protobuf:"fixed64,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	GcsPath       string        `protobuf:"bytes,6,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
+	Url           string        `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	FileSize      int64         `protobuf:"varint,8,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	CameraId      string        `protobuf:"bytes,9,opt,name=camera_id,json=cameraId,proto3" json:"camera_id,omitempty"`
+	Location      *LocationData `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrameExtractionEventData) Reset() {
+	*x = FrameExtractionEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameExtractionEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameExtractionEventData) ProtoMessage() {}
+
+func (x *FrameExtractionEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameExtractionEventData.ProtoReflect.Descriptor instead.
+func (*FrameExtractionEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FrameExtractionEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *FrameExtractionEventData) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *FrameExtractionEventData) GetFrameId() string {
+	if x != nil {
+		return x.FrameId
+	}
+	return ""
+}
+
+func (x *FrameExtractionEventData) GetFrameNumber() int64 {
+	if x != nil {
+		return x.FrameNumber
+	}
+	return 0
+}
+
+func (x *FrameExtractionEventData) GetTimestamp() float64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *FrameExtractionEventData) GetGcsPath() string {
+	if x != nil {
+		return x.GcsPath
+	}
+	return ""
+}
+
+func (x *FrameExtractionEventData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FrameExtractionEventData) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *FrameExtractionEventData) GetCameraId() string {
+	if x != nil {
+		return x.CameraId
+	}
+	return ""
+}
+
+func (x *FrameExtractionEventData) GetLocation() *LocationData {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type FrameUploadCompleteEventData struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent   *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	VideoId     string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	FrameId     string                 `protobuf:"bytes,3,opt,name=frame_id,json=frameId,proto3" json:"frame_id,omitempty"`
+	FrameNumber int64                  `protobuf:"varint,4,opt,name=frame_number,json=frameNumber,proto3" json:"frame_number,omitempty"`
+	// Seconds from video start; the event time is base_event.timestamp
+	Timestamp     float64                `protobuf:"fixed64,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	GcsPath       string                 `protobuf:"bytes,6,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
+	Url           string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	FileSize      int64                  `protobuf:"varint,8,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	VerifiedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	RetryCount    int64                  `protobuf:"varint,10,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	CameraId      string                 `protobuf:"bytes,11,opt,name=camera_id,json=cameraId,proto3" json:"camera_id,omitempty"`
+	Location      *LocationData          `protobuf:"bytes,12,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrameUploadCompleteEventData) Reset() {
+	*x = FrameUploadCompleteEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameUploadCompleteEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameUploadCompleteEventData) ProtoMessage() {}
+
+func (x *FrameUploadCompleteEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameUploadCompleteEventData.ProtoReflect.Descriptor instead.
+func (*FrameUploadCompleteEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FrameUploadCompleteEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *FrameUploadCompleteEventData) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *FrameUploadCompleteEventData) GetFrameId() string {
+	if x != nil {
+		return x.FrameId
+	}
+	return ""
+}
+
+func (x *FrameUploadCompleteEventData) GetFrameNumber() int64 {
+	if x != nil {
+		return x.FrameNumber
+	}
+	return 0
+}
+
+func (x *FrameUploadCompleteEventData) GetTimestamp() float64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *FrameUploadCompleteEventData) GetGcsPath() string {
+	if x != nil {
+		return x.GcsPath
+	}
+	return ""
+}
+
+func (x *FrameUploadCompleteEventData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FrameUploadCompleteEventData) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *FrameUploadCompleteEventData) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+func (x *FrameUploadCompleteEventData) GetRetryCount() int64 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *FrameUploadCompleteEventData) GetCameraId() string {
+	if x != nil {
+		return x.CameraId
+	}
+	return ""
+}
+
+func (x *FrameUploadCompleteEventData) GetLocation() *LocationData {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type DetectedObject struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	BoundingBox   *BoundingBox           `protobuf:"bytes,3,opt,name=bounding_box,json=boundingBox,proto3" json:"bounding_box,omitempty"`
+	Attributes    *structpb.Struct       `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectedObject) Reset() {
+	*x = DetectedObject{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectedObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedObject) ProtoMessage() {}
+
+func (x *DetectedObject) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectedObject.ProtoReflect.Descriptor instead.
+func (*DetectedObject) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DetectedObject) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DetectedObject) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *DetectedObject) GetBoundingBox() *BoundingBox {
+	if x != nil {
+		return x.BoundingBox
+	}
+	return nil
+}
+
+func (x *DetectedObject) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type DetectedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Location      *LocationData          `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectedEvent) Reset() {
+	*x = DetectedEvent{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedEvent) ProtoMessage() {}
+
+func (x *DetectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectedEvent.ProtoReflect.Descriptor instead.
+func (*DetectedEvent) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DetectedEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *DetectedEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetLocation() *LocationData {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *DetectedEvent) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type AIAnalysisEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	FrameId       string                 `protobuf:"bytes,3,opt,name=frame_id,json=frameId,proto3" json:"frame_id,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Objects       []*DetectedObject      `protobuf:"bytes,5,rep,name=objects,proto3" json:"objects,omitempty"`
+	Events        []*DetectedEvent       `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIAnalysisEventData) Reset() {
+	*x = AIAnalysisEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIAnalysisEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIAnalysisEventData) ProtoMessage() {}
+
+func (x *AIAnalysisEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIAnalysisEventData.ProtoReflect.Descriptor instead.
+func (*AIAnalysisEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AIAnalysisEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *AIAnalysisEventData) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *AIAnalysisEventData) GetFrameId() string {
+	if x != nil {
+		return x.FrameId
+	}
+	return ""
+}
+
+func (x *AIAnalysisEventData) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *AIAnalysisEventData) GetObjects() []*DetectedObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *AIAnalysisEventData) GetEvents() []*DetectedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *AIAnalysisEventData) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type EventAnalysisEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	FrameId       string                 `protobuf:"bytes,3,opt,name=frame_id,json=frameId,proto3" json:"frame_id,omitempty"`
+	FrameNumber   int64                  `protobuf:"varint,4,opt,name=frame_number,json=frameNumber,proto3" json:"frame_number,omitempty"`
+	AnalysisType  string                 `protobuf:"bytes,5,opt,name=analysis_type,json=analysisType,proto3" json:"analysis_type,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Summary       string                 `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	DetectedItems []string               `protobuf:"bytes,8,rep,name=detected_items,json=detectedItems,proto3" json:"detected_items,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,9,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Severity      string                 `protobuf:"bytes,10,opt,name=severity,proto3" json:"severity,omitempty"`
+	Category      string                 `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
+	CameraId      string                 `protobuf:"bytes,12,opt,name=camera_id,json=cameraId,proto3" json:"camera_id,omitempty"`
+	Location      *LocationData          `protobuf:"bytes,13,opt,name=location,proto3" json:"location,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,14,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	RawResponse   string                 `protobuf:"bytes,15,opt,name=raw_response,json=rawResponse,proto3" json:"raw_response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventAnalysisEventData) Reset() {
+	*x = EventAnalysisEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventAnalysisEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAnalysisEventData) ProtoMessage() {}
+
+func (x *EventAnalysisEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventAnalysisEventData.ProtoReflect.Descriptor instead.
+func (*EventAnalysisEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EventAnalysisEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *EventAnalysisEventData) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *EventAnalysisEventData) GetFrameId() string {
+	if x != nil {
+		return x.FrameId
+	}
+	return ""
+}
+
+func (x *EventAnalysisEventData) GetFrameNumber() int64 {
+	if x != nil {
+		return x.FrameNumber
+	}
+	return 0
+}
+
+func (x *EventAnalysisEventData) GetAnalysisType() string {
+	if x != nil {
+		return x.AnalysisType
+	}
+	return ""
+}
+
+func (x *EventAnalysisEventData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EventAnalysisEventData) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *EventAnalysisEventData) GetDetectedItems() []string {
+	if x != nil {
+		return x.DetectedItems
+	}
+	return nil
+}
+
+func (x *EventAnalysisEventData) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *EventAnalysisEventData) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *EventAnalysisEventData) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *EventAnalysisEventData) GetCameraId() string {
+	if x != nil {
+		return x.CameraId
+	}
+	return ""
+}
+
+func (x *EventAnalysisEventData) GetLocation() *LocationData {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *EventAnalysisEventData) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *EventAnalysisEventData) GetRawResponse() string {
+	if x != nil {
+		return x.RawResponse
+	}
+	return ""
+}
+
+type SuggestionCreatedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	SuggestionId  string                 `protobuf:"bytes,2,opt,name=suggestion_id,json=suggestionId,proto3" json:"suggestion_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	MissionId     string                 `protobuf:"bytes,4,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	MissionTitle  string                 `protobuf:"bytes,5,opt,name=mission_title,json=missionTitle,proto3" json:"mission_title,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Reasoning     string                 `protobuf:"bytes,7,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestionCreatedEventData) Reset() {
+	*x = SuggestionCreatedEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestionCreatedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionCreatedEventData) ProtoMessage() {}
+
+func (x *SuggestionCreatedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionCreatedEventData.ProtoReflect.Descriptor instead.
+func (*SuggestionCreatedEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SuggestionCreatedEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *SuggestionCreatedEventData) GetSuggestionId() string {
+	if x != nil {
+		return x.SuggestionId
+	}
+	return ""
+}
+
+func (x *SuggestionCreatedEventData) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SuggestionCreatedEventData) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *SuggestionCreatedEventData) GetMissionTitle() string {
+	if x != nil {
+		return x.MissionTitle
+	}
+	return ""
+}
+
+func (x *SuggestionCreatedEventData) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *SuggestionCreatedEventData) GetReasoning() string {
+	if x != nil {
+		return x.Reasoning
+	}
+	return ""
+}
+
+type MissionCreatedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	MissionId     string                 `protobuf:"bytes,2,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Priority      string                 `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Location      *LocationData          `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	AssetIds      []string               `protobuf:"bytes,8,rep,name=asset_ids,json=assetIds,proto3" json:"asset_ids,omitempty"`
+	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MissionCreatedEventData) Reset() {
+	*x = MissionCreatedEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissionCreatedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionCreatedEventData) ProtoMessage() {}
+
+func (x *MissionCreatedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissionCreatedEventData.ProtoReflect.Descriptor instead.
+func (*MissionCreatedEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MissionCreatedEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *MissionCreatedEventData) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *MissionCreatedEventData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MissionCreatedEventData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MissionCreatedEventData) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *MissionCreatedEventData) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MissionCreatedEventData) GetLocation() *LocationData {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *MissionCreatedEventData) GetAssetIds() []string {
+	if x != nil {
+		return x.AssetIds
+	}
+	return nil
+}
+
+func (x *MissionCreatedEventData) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MissionCreatedEventData) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type TacticalCommandSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	TargetType    string                 `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	TargetName    string                 `protobuf:"bytes,6,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	Priority      string                 `protobuf:"bytes,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Reasoning     string                 `protobuf:"bytes,8,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TacticalCommandSuggestion) Reset() {
+	*x = TacticalCommandSuggestion{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TacticalCommandSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TacticalCommandSuggestion) ProtoMessage() {}
+
+func (x *TacticalCommandSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TacticalCommandSuggestion.ProtoReflect.Descriptor instead.
+func (*TacticalCommandSuggestion) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TacticalCommandSuggestion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TacticalCommandSuggestion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TacticalCommandSuggestion) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TacticalCommandSuggestion) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *TacticalCommandSuggestion) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *TacticalCommandSuggestion) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *TacticalCommandSuggestion) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *TacticalCommandSuggestion) GetReasoning() string {
+	if x != nil {
+		return x.Reasoning
+	}
+	return ""
+}
+
+type AIMissionSuggestionEventData struct {
+	state            protoimpl.MessageState       `protogen:"open.v1"`
+	BaseEvent        *BaseEvent                   `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	MissionId        string                       `protobuf:"bytes,2,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	MissionTitle     string                       `protobuf:"bytes,3,opt,name=mission_title,json=missionTitle,proto3" json:"mission_title,omitempty"`
+	TacticalCommands []*TacticalCommandSuggestion `protobuf:"bytes,4,rep,name=tactical_commands,json=tacticalCommands,proto3" json:"tactical_commands,omitempty"`
+	Analysis         string                       `protobuf:"bytes,5,opt,name=analysis,proto3" json:"analysis,omitempty"`
+	Confidence       float64                      `protobuf:"fixed64,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AIMissionSuggestionEventData) Reset() {
+	*x = AIMissionSuggestionEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIMissionSuggestionEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIMissionSuggestionEventData) ProtoMessage() {}
+
+func (x *AIMissionSuggestionEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIMissionSuggestionEventData.ProtoReflect.Descriptor instead.
+func (*AIMissionSuggestionEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AIMissionSuggestionEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *AIMissionSuggestionEventData) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *AIMissionSuggestionEventData) GetMissionTitle() string {
+	if x != nil {
+		return x.MissionTitle
+	}
+	return ""
+}
+
+func (x *AIMissionSuggestionEventData) GetTacticalCommands() []*TacticalCommandSuggestion {
+	if x != nil {
+		return x.TacticalCommands
+	}
+	return nil
+}
+
+func (x *AIMissionSuggestionEventData) GetAnalysis() string {
+	if x != nil {
+		return x.Analysis
+	}
+	return ""
+}
+
+func (x *AIMissionSuggestionEventData) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type TacticalCommandTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	TargetName    string                 `protobuf:"bytes,3,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TacticalCommandTarget) Reset() {
+	*x = TacticalCommandTarget{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TacticalCommandTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TacticalCommandTarget) ProtoMessage() {}
+
+func (x *TacticalCommandTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TacticalCommandTarget.ProtoReflect.Descriptor instead.
+func (*TacticalCommandTarget) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TacticalCommandTarget) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *TacticalCommandTarget) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *TacticalCommandTarget) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+type TacticalGeoLocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TacticalGeoLocation) Reset() {
+	*x = TacticalGeoLocation{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TacticalGeoLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TacticalGeoLocation) ProtoMessage() {}
+
+func (x *TacticalGeoLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TacticalGeoLocation.ProtoReflect.Descriptor instead.
+func (*TacticalGeoLocation) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TacticalGeoLocation) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *TacticalGeoLocation) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+func (x *TacticalGeoLocation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TacticalGeoLocation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type TacticalGeoArea struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Center        *TacticalGeoLocation   `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	Radius        float64                `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	Coordinates   []*TacticalGeoLocation `protobuf:"bytes,4,rep,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TacticalGeoArea) Reset() {
+	*x = TacticalGeoArea{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TacticalGeoArea) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TacticalGeoArea) ProtoMessage() {}
+
+func (x *TacticalGeoArea) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TacticalGeoArea.ProtoReflect.Descriptor instead.
+func (*TacticalGeoArea) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TacticalGeoArea) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TacticalGeoArea) GetCenter() *TacticalGeoLocation {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *TacticalGeoArea) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *TacticalGeoArea) GetCoordinates() []*TacticalGeoLocation {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *TacticalGeoArea) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TacticalCommandCreatedEventData struct {
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	BaseEvent        *BaseEvent               `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	CommandId        string                   `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	MissionId        string                   `protobuf:"bytes,3,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	MissionTitle     string                   `protobuf:"bytes,4,opt,name=mission_title,json=missionTitle,proto3" json:"mission_title,omitempty"`
+	Title            string                   `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                   `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Category         string                   `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Targets          []*TacticalCommandTarget `protobuf:"bytes,8,rep,name=targets,proto3" json:"targets,omitempty"`
+	Priority         string                   `protobuf:"bytes,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	CommandSource    string                   `protobuf:"bytes,10,opt,name=command_source,json=commandSource,proto3" json:"command_source,omitempty"`
+	Destination      *TacticalGeoLocation     `protobuf:"bytes,11,opt,name=destination,proto3" json:"destination,omitempty"`
+	AreaOfOperation  *TacticalGeoArea         `protobuf:"bytes,12,opt,name=area_of_operation,json=areaOfOperation,proto3" json:"area_of_operation,omitempty"`
+	Objective        string                   `protobuf:"bytes,13,opt,name=objective,proto3" json:"objective,omitempty"`
+	SituationSummary string                   `protobuf:"bytes,14,opt,name=situation_summary,json=situationSummary,proto3" json:"situation_summary,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TacticalCommandCreatedEventData) Reset() {
+	*x = TacticalCommandCreatedEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TacticalCommandCreatedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TacticalCommandCreatedEventData) ProtoMessage() {}
+
+func (x *TacticalCommandCreatedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TacticalCommandCreatedEventData.ProtoReflect.Descriptor instead.
+func (*TacticalCommandCreatedEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TacticalCommandCreatedEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *TacticalCommandCreatedEventData) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *TacticalCommandCreatedEventData) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *TacticalCommandCreatedEventData) GetMissionTitle() string {
+	if x != nil {
+		return x.MissionTitle
+	}
+	return ""
+}
+
+func (x *TacticalCommandCreatedEventData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TacticalCommandCreatedEventData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TacticalCommandCreatedEventData) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TacticalCommandCreatedEventData) GetTargets() []*TacticalCommandTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *TacticalCommandCreatedEventData) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *TacticalCommandCreatedEventData) GetCommandSource() string {
+	if x != nil {
+		return x.CommandSource
+	}
+	return ""
+}
+
+func (x *TacticalCommandCreatedEventData) GetDestination() *TacticalGeoLocation {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *TacticalCommandCreatedEventData) GetAreaOfOperation() *TacticalGeoArea {
+	if x != nil {
+		return x.AreaOfOperation
+	}
+	return nil
+}
+
+func (x *TacticalCommandCreatedEventData) GetObjective() string {
+	if x != nil {
+		return x.Objective
+	}
+	return ""
+}
+
+func (x *TacticalCommandCreatedEventData) GetSituationSummary() string {
+	if x != nil {
+		return x.SituationSummary
+	}
+	return ""
+}
+
+type TacticalCommandResponseEventData struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent       *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	CommandId       string                 `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	MissionId       string                 `protobuf:"bytes,3,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	TargetId        string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	TargetType      string                 `protobuf:"bytes,5,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetName      string                 `protobuf:"bytes,6,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	Decision        string                 `protobuf:"bytes,7,opt,name=decision,proto3" json:"decision,omitempty"`
+	Notes           string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	RespondedBy     string                 `protobuf:"bytes,9,opt,name=responded_by,json=respondedBy,proto3" json:"responded_by,omitempty"`
+	RespondedByName string                 `protobuf:"bytes,10,opt,name=responded_by_name,json=respondedByName,proto3" json:"responded_by_name,omitempty"`
+	NewStatus       string                 `protobuf:"bytes,11,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TacticalCommandResponseEventData) Reset() {
+	*x = TacticalCommandResponseEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TacticalCommandResponseEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TacticalCommandResponseEventData) ProtoMessage() {}
+
+func (x *TacticalCommandResponseEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TacticalCommandResponseEventData.ProtoReflect.Descriptor instead.
+func (*TacticalCommandResponseEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TacticalCommandResponseEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *TacticalCommandResponseEventData) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *TacticalCommandResponseEventData) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *TacticalCommandResponseEventData) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *TacticalCommandResponseEventData) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *TacticalCommandResponseEventData) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *TacticalCommandResponseEventData) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *TacticalCommandResponseEventData) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *TacticalCommandResponseEventData) GetRespondedBy() string {
+	if x != nil {
+		return x.RespondedBy
+	}
+	return ""
+}
+
+func (x *TacticalCommandResponseEventData) GetRespondedByName() string {
+	if x != nil {
+		return x.RespondedByName
+	}
+	return ""
+}
+
+func (x *TacticalCommandResponseEventData) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+type TacticalCommandStatusEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	CommandId     string                 `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	MissionId     string                 `protobuf:"bytes,3,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	CommandTitle  string                 `protobuf:"bytes,4,opt,name=command_title,json=commandTitle,proto3" json:"command_title,omitempty"`
+	OldStatus     string                 `protobuf:"bytes,5,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus     string                 `protobuf:"bytes,6,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedByName string                 `protobuf:"bytes,8,opt,name=updated_by_name,json=updatedByName,proto3" json:"updated_by_name,omitempty"`
+	Notes         string                 `protobuf:"bytes,9,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TacticalCommandStatusEventData) Reset() {
+	*x = TacticalCommandStatusEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TacticalCommandStatusEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TacticalCommandStatusEventData) ProtoMessage() {}
+
+func (x *TacticalCommandStatusEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TacticalCommandStatusEventData.ProtoReflect.Descriptor instead.
+func (*TacticalCommandStatusEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TacticalCommandStatusEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *TacticalCommandStatusEventData) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *TacticalCommandStatusEventData) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *TacticalCommandStatusEventData) GetCommandTitle() string {
+	if x != nil {
+		return x.CommandTitle
+	}
+	return ""
+}
+
+func (x *TacticalCommandStatusEventData) GetOldStatus() string {
+	if x != nil {
+		return x.OldStatus
+	}
+	return ""
+}
+
+func (x *TacticalCommandStatusEventData) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *TacticalCommandStatusEventData) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *TacticalCommandStatusEventData) GetUpdatedByName() string {
+	if x != nil {
+		return x.UpdatedByName
+	}
+	return ""
+}
+
+func (x *TacticalCommandStatusEventData) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type MissionChatMessageEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	MissionId     string                 `protobuf:"bytes,2,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	SenderId      string                 `protobuf:"bytes,4,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderName    string                 `protobuf:"bytes,5,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	SenderRole    string                 `protobuf:"bytes,6,opt,name=sender_role,json=senderRole,proto3" json:"sender_role,omitempty"`
+	Content       string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MissionChatMessageEventData) Reset() {
+	*x = MissionChatMessageEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissionChatMessageEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionChatMessageEventData) ProtoMessage() {}
+
+func (x *MissionChatMessageEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissionChatMessageEventData.ProtoReflect.Descriptor instead.
+func (*MissionChatMessageEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MissionChatMessageEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *MissionChatMessageEventData) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *MissionChatMessageEventData) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MissionChatMessageEventData) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *MissionChatMessageEventData) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *MissionChatMessageEventData) GetSenderRole() string {
+	if x != nil {
+		return x.SenderRole
+	}
+	return ""
+}
+
+func (x *MissionChatMessageEventData) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type MissionTypingUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MissionTypingUser) Reset() {
+	*x = MissionTypingUser{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissionTypingUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionTypingUser) ProtoMessage() {}
+
+func (x *MissionTypingUser) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissionTypingUser.ProtoReflect.Descriptor instead.
+func (*MissionTypingUser) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MissionTypingUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MissionTypingUser) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type MissionTypingIndicatorEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	MissionId     string                 `protobuf:"bytes,2,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	TypingUsers   []*MissionTypingUser   `protobuf:"bytes,3,rep,name=typing_users,json=typingUsers,proto3" json:"typing_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MissionTypingIndicatorEventData) Reset() {
+	*x = MissionTypingIndicatorEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissionTypingIndicatorEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionTypingIndicatorEventData) ProtoMessage() {}
+
+func (x *MissionTypingIndicatorEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissionTypingIndicatorEventData.ProtoReflect.Descriptor instead.
+func (*MissionTypingIndicatorEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MissionTypingIndicatorEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *MissionTypingIndicatorEventData) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *MissionTypingIndicatorEventData) GetTypingUsers() []*MissionTypingUser {
+	if x != nil {
+		return x.TypingUsers
+	}
+	return nil
+}
+
+// Mirrors models.FWIInfo
+type FWIInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Rating        int64                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FWIInfo) Reset() {
+	*x = FWIInfo{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FWIInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FWIInfo) ProtoMessage() {}
+
+func (x *FWIInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FWIInfo.ProtoReflect.Descriptor instead.
+func (*FWIInfo) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FWIInfo) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *FWIInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *FWIInfo) GetRating() int64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+// Mirrors models.FireDetail
+type FireDetail struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FireId          string                 `protobuf:"bytes,1,opt,name=fire_id,json=fireId,proto3" json:"fire_id,omitempty"`
+	Source          string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	SatelliteSource string                 `protobuf:"bytes,3,opt,name=satellite_source,json=satelliteSource,proto3" json:"satellite_source,omitempty"`
+	Distance        float64                `protobuf:"fixed64,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	InFire          bool                   `protobuf:"varint,5,opt,name=in_fire,json=inFire,proto3" json:"in_fire,omitempty"`
+	Intensity       float64                `protobuf:"fixed64,6,opt,name=intensity,proto3" json:"intensity,omitempty"`
+	Confidence      string                 `protobuf:"bytes,7,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FireDetail) Reset() {
+	*x = FireDetail{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FireDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FireDetail) ProtoMessage() {}
+
+func (x *FireDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FireDetail.ProtoReflect.Descriptor instead.
+func (*FireDetail) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FireDetail) GetFireId() string {
+	if x != nil {
+		return x.FireId
+	}
+	return ""
+}
+
+func (x *FireDetail) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FireDetail) GetSatelliteSource() string {
+	if x != nil {
+		return x.SatelliteSource
+	}
+	return ""
+}
+
+func (x *FireDetail) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *FireDetail) GetInFire() bool {
+	if x != nil {
+		return x.InFire
+	}
+	return false
+}
+
+func (x *FireDetail) GetIntensity() float64 {
+	if x != nil {
+		return x.Intensity
+	}
+	return 0
+}
+
+func (x *FireDetail) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+// Mirrors models.ScoreFactors
+type ScoreFactors struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DistanceScore   float64                `protobuf:"fixed64,1,opt,name=distance_score,json=distanceScore,proto3" json:"distance_score,omitempty"`
+	IntensityScore  float64                `protobuf:"fixed64,2,opt,name=intensity_score,json=intensityScore,proto3" json:"intensity_score,omitempty"`
+	ConfidenceScore float64                `protobuf:"fixed64,3,opt,name=confidence_score,json=confidenceScore,proto3" json:"confidence_score,omitempty"`
+	FwiScore        float64                `protobuf:"fixed64,4,opt,name=fwi_score,json=fwiScore,proto3" json:"fwi_score,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScoreFactors) Reset() {
+	*x = ScoreFactors{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreFactors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreFactors) ProtoMessage() {}
+
+func (x *ScoreFactors) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreFactors.ProtoReflect.Descriptor instead.
+func (*ScoreFactors) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ScoreFactors) GetDistanceScore() float64 {
+	if x != nil {
+		return x.DistanceScore
+	}
+	return 0
+}
+
+func (x *ScoreFactors) GetIntensityScore() float64 {
+	if x != nil {
+		return x.IntensityScore
+	}
+	return 0
+}
+
+func (x *ScoreFactors) GetConfidenceScore() float64 {
+	if x != nil {
+		return x.ConfidenceScore
+	}
+	return 0
+}
+
+func (x *ScoreFactors) GetFwiScore() float64 {
+	if x != nil {
+		return x.FwiScore
+	}
+	return 0
+}
+
+// Mirrors models.FireEvent
+type FireEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LocationId    string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	LocationName  string                 `protobuf:"bytes,3,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
+	LocationType  string                 `protobuf:"bytes,4,opt,name=location_type,json=locationType,proto3" json:"location_type,omitempty"`
+	EventType     string                 `protobuf:"bytes,5,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	RiskLevel     string                 `protobuf:"bytes,6,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	RiskScore     float64                `protobuf:"fixed64,7,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	Fires         []*FireDetail          `protobuf:"bytes,8,rep,name=fires,proto3" json:"fires,omitempty"`
+	Fwi           *FWIInfo               `protobuf:"bytes,9,opt,name=fwi,proto3" json:"fwi,omitempty"`
+	ScoreFactors  *ScoreFactors          `protobuf:"bytes,10,opt,name=score_factors,json=scoreFactors,proto3" json:"score_factors,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status        string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	TenantId      string                 `protobuf:"bytes,14,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FireEvent) Reset() {
+	*x = FireEvent{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FireEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FireEvent) ProtoMessage() {}
+
+func (x *FireEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FireEvent.ProtoReflect.Descriptor instead.
+func (*FireEvent) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FireEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FireEvent) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *FireEvent) GetLocationName() string {
+	if x != nil {
+		return x.LocationName
+	}
+	return ""
+}
+
+func (x *FireEvent) GetLocationType() string {
+	if x != nil {
+		return x.LocationType
+	}
+	return ""
+}
+
+func (x *FireEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *FireEvent) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *FireEvent) GetRiskScore() float64 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *FireEvent) GetFires() []*FireDetail {
+	if x != nil {
+		return x.Fires
+	}
+	return nil
+}
+
+func (x *FireEvent) GetFwi() *FWIInfo {
+	if x != nil {
+		return x.Fwi
+	}
+	return nil
+}
+
+func (x *FireEvent) GetScoreFactors() *ScoreFactors {
+	if x != nil {
+		return x.ScoreFactors
+	}
+	return nil
+}
+
+func (x *FireEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FireEvent) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *FireEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FireEvent) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// Mirrors models.Alert
+type Alert struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Severity       string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	LocationId     string                 `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	LocationName   string                 `protobuf:"bytes,5,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
+	Message        string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	FireEventId    string                 `protobuf:"bytes,7,opt,name=fire_event_id,json=fireEventId,proto3" json:"fire_event_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status         string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	AcknowledgedBy string                 `protobuf:"bytes,12,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+	TenantId       string                 `protobuf:"bytes,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Alert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alert) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Alert) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Alert) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *Alert) GetLocationName() string {
+	if x != nil {
+		return x.LocationName
+	}
+	return ""
+}
+
+func (x *Alert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Alert) GetFireEventId() string {
+	if x != nil {
+		return x.FireEventId
+	}
+	return ""
+}
+
+func (x *Alert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Alert) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Alert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Alert) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+func (x *Alert) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+func (x *Alert) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type FireRiskEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	FireEvent     *FireEvent             `protobuf:"bytes,2,opt,name=fire_event,json=fireEvent,proto3" json:"fire_event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FireRiskEventData) Reset() {
+	*x = FireRiskEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FireRiskEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FireRiskEventData) ProtoMessage() {}
+
+func (x *FireRiskEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FireRiskEventData.ProtoReflect.Descriptor instead.
+func (*FireRiskEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FireRiskEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *FireRiskEventData) GetFireEvent() *FireEvent {
+	if x != nil {
+		return x.FireEvent
+	}
+	return nil
+}
+
+type FireAlertCreatedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseEvent     *BaseEvent             `protobuf:"bytes,1,opt,name=base_event,json=baseEvent,proto3" json:"base_event,omitempty"`
+	AlertId       string                 `protobuf:"bytes,2,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	FireEventId   string                 `protobuf:"bytes,3,opt,name=fire_event_id,json=fireEventId,proto3" json:"fire_event_id,omitempty"`
+	LocationId    string                 `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	LocationName  string                 `protobuf:"bytes,5,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
+	Severity      string                 `protobuf:"bytes,6,opt,name=severity,proto3" json:"severity,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	RiskScore     float64                `protobuf:"fixed64,8,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	Location      *LocationData          `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	Alert         *Alert                 `protobuf:"bytes,10,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FireAlertCreatedEventData) Reset() {
+	*x = FireAlertCreatedEventData{}
+	mi := &file_phlx_events_v1_events_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FireAlertCreatedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FireAlertCreatedEventData) ProtoMessage() {}
+
+func (x *FireAlertCreatedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_phlx_events_v1_events_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FireAlertCreatedEventData.ProtoReflect.Descriptor instead.
+func (*FireAlertCreatedEventData) Descriptor() ([]byte, []int) {
+	return file_phlx_events_v1_events_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FireAlertCreatedEventData) GetBaseEvent() *BaseEvent {
+	if x != nil {
+		return x.BaseEvent
+	}
+	return nil
+}
+
+func (x *FireAlertCreatedEventData) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *FireAlertCreatedEventData) GetFireEventId() string {
+	if x != nil {
+		return x.FireEventId
+	}
+	return ""
+}
+
+func (x *FireAlertCreatedEventData) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *FireAlertCreatedEventData) GetLocationName() string {
+	if x != nil {
+		return x.LocationName
+	}
+	return ""
+}
+
+func (x *FireAlertCreatedEventData) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *FireAlertCreatedEventData) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FireAlertCreatedEventData) GetRiskScore() float64 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *FireAlertCreatedEventData) GetLocation() *LocationData {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *FireAlertCreatedEventData) GetAlert() *Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+var File_phlx_events_v1_events_proto protoreflect.FileDescriptor
+
+const file_phlx_events_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\x1bphlx/events/v1/events.proto\x12\x0ephlx.events.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x03\n" +
+	"\tBaseEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12%\n" +
+	"\x0eschema_version\x18\x05 \x01(\tR\rschemaVersion\x12%\n" +
+	"\x0ecorrelation_id\x18\x06 \x01(\tR\rcorrelationId\x12!\n" +
+	"\fcausation_id\x18\a \x01(\tR\vcausationId\x12 \n" +
+	"\vtraceparent\x18\b \x01(\tR\vtraceparent\x12\x1e\n" +
+	"\n" +
+	"tracestate\x18\t \x01(\tR\n" +
+	"tracestate\x12!\n" +
+	"\faggregate_id\x18\n" +
+	" \x01(\tR\vaggregateId\x12\x1a\n" +
+	"\bsequence\x18\v \x01(\x03R\bsequence\x12\x1b\n" +
+	"\ttenant_id\x18\f \x01(\tR\btenantId\x12?\n" +
+	"\n" +
+	"encryption\x18\r \x01(\v2\x1f.phlx.events.v1.FieldEncryptionR\n" +
+	"encryption\"{\n" +
+	"\x0fFieldEncryption\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x19\n" +
+	"\bdata_key\x18\x02 \x01(\fR\adataKey\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x04 \x01(\fR\n" +
+	"ciphertext\"\x92\x01\n" +
+	"\fLocationData\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\baltitude\x18\x03 \x01(\x01R\baltitude\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04area\x18\x05 \x01(\tR\x04area\"W\n" +
+	"\vBoundingBox\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x03R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x03R\x01y\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x03R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x03R\x06height\"\xd6\x02\n" +
+	"\x14AssetUpdateEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x1d\n" +
+	"\n" +
+	"asset_name\x18\x03 \x01(\tR\tassetName\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x04 \x01(\tR\tassetType\x12\x1d\n" +
+	"\n" +
+	"old_status\x18\x05 \x01(\tR\toldStatus\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\x06 \x01(\tR\tnewStatus\x128\n" +
+	"\blocation\x18\a \x01(\v2\x1c.phlx.events.v1.LocationDataR\blocation\x123\n" +
+	"\bmetadata\x18\b \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\xe5\x03\n" +
+	"\x14AssetRecallEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x1d\n" +
+	"\n" +
+	"asset_name\x18\x03 \x01(\tR\tassetName\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x04 \x01(\tR\tmissionId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12$\n" +
+	"\x0ereturn_base_id\x18\a \x01(\tR\freturnBaseId\x12(\n" +
+	"\x10return_base_name\x18\b \x01(\tR\x0ereturnBaseName\x127\n" +
+	"\treturn_by\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\breturnBy\x12\x1f\n" +
+	"\vrecalled_by\x18\n" +
+	" \x01(\tR\n" +
+	"recalledBy\x12(\n" +
+	"\x10recalled_by_name\x18\v \x01(\tR\x0erecalledByName\x128\n" +
+	"\blocation\x18\f \x01(\v2\x1c.phlx.events.v1.LocationDataR\blocation\"\xde\x03\n" +
+	"\x1eEmergencyNotificationEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\tR\x0enotificationId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1a\n" +
+	"\bseverity\x18\x05 \x01(\tR\bseverity\x12\x12\n" +
+	"\x04area\x18\x06 \x01(\tR\x04area\x12'\n" +
+	"\x0frecipient_count\x18\a \x01(\x03R\x0erecipientCount\x12>\n" +
+	"\vcoordinates\x18\b \x01(\v2\x1c.phlx.events.v1.LocationDataR\vcoordinates\x12\"\n" +
+	"\facknowledged\x18\t \x01(\bR\facknowledged\x12'\n" +
+	"\x0facknowledged_by\x18\n" +
+	" \x01(\tR\x0eacknowledgedBy\x12C\n" +
+	"\x0facknowledged_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0eacknowledgedAt\"\xf0\x01\n" +
+	"\x14ChatMessageEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x16\n" +
+	"\x06sender\x18\x04 \x01(\tR\x06sender\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\x12\x18\n" +
+	"\acommand\x18\x06 \x01(\tR\acommand\x12\x1a\n" +
+	"\bresponse\x18\a \x01(\tR\bresponse\"\x93\x02\n" +
+	"\x17LocationUpdateEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x1d\n" +
+	"\n" +
+	"asset_name\x18\x03 \x01(\tR\tassetName\x128\n" +
+	"\blocation\x18\x04 \x01(\v2\x1c.phlx.events.v1.LocationDataR\blocation\x12\x14\n" +
+	"\x05speed\x18\x05 \x01(\x01R\x05speed\x12\x18\n" +
+	"\aheading\x18\x06 \x01(\x01R\aheading\x12\x1a\n" +
+	"\baltitude\x18\a \x01(\x01R\baltitude\"\xbd\x02\n" +
+	"\x15VitalsUpdateEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12!\n" +
+	"\fpersonnel_id\x18\x02 \x01(\tR\vpersonnelId\x12%\n" +
+	"\x0epersonnel_name\x18\x03 \x01(\tR\rpersonnelName\x12\x1d\n" +
+	"\n" +
+	"pulse_rate\x18\x04 \x01(\x03R\tpulseRate\x12!\n" +
+	"\foxygen_level\x18\x05 \x01(\x03R\voxygenLevel\x12 \n" +
+	"\vtemperature\x18\x06 \x01(\x01R\vtemperature\x12\x19\n" +
+	"\bis_alert\x18\a \x01(\bR\aisAlert\x12!\n" +
+	"\falert_reason\x18\b \x01(\tR\valertReason\"\xd0\x02\n" +
+	"\x15SystemStatusEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0fprevious_status\x18\x03 \x01(\tR\x0epreviousStatus\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x04 \x01(\tR\tchangedBy\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12#\n" +
+	"\ractive_assets\x18\x06 \x01(\x03R\factiveAssets\x12+\n" +
+	"\x11dispatched_assets\x18\a \x01(\x03R\x10dispatchedAssets\x123\n" +
+	"\bmetadata\x18\b \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\x86\x03\n" +
+	"\x14VideoUploadEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x1d\n" +
+	"\n" +
+	"video_name\x18\x03 \x01(\tR\tvideoName\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\x01R\bduration\x12\x1b\n" +
+	"\tfile_size\x18\x06 \x01(\x03R\bfileSize\x12\x1f\n" +
+	"\vuploaded_by\x18\a \x01(\tR\n" +
+	"uploadedBy\x12\x19\n" +
+	"\bgcs_path\x18\b \x01(\tR\agcsPath\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1b\n" +
+	"\tcamera_id\x18\n" +
+	" \x01(\tR\bcameraId\x128\n" +
+	"\blocation\x18\v \x01(\v2\x1c.phlx.events.v1.LocationDataR\blocation\"\xd5\x02\n" +
+	"\x18VideoProcessingEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x19\n" +
+	"\bjob_type\x18\x03 \x01(\tR\ajobType\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\bprogress\x18\x05 \x01(\x01R\bprogress\x12\x1b\n" +
+	"\terror_msg\x18\x06 \x01(\tR\berrorMsg\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xec\x02\n" +
+	"\x18FrameExtractionEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x19\n" +
+	"\bframe_id\x18\x03 \x01(\tR\aframeId\x12!\n" +
+	"\fframe_number\x18\x04 \x01(\x03R\vframeNumber\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x01R\ttimestamp\x12\x19\n" +
+	"\bgcs_path\x18\x06 \x01(\tR\agcsPath\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12\x1b\n" +
+	"\tfile_size\x18\b \x01(\x03R\bfileSize\x12\x1b\n" +
+	"\tcamera_id\x18\t \x01(\tR\bcameraId\x128\n" +
+	"\blocation\x18\n" +
+	" \x01(\v2\x1c.phlx.events.v1.LocationDataR\blocation\"\xce\x03\n" +
+	"\x1cFrameUploadCompleteEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x19\n" +
+	"\bframe_id\x18\x03 \x01(\tR\aframeId\x12!\n" +
+	"\fframe_number\x18\x04 \x01(\x03R\vframeNumber\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x01R\ttimestamp\x12\x19\n" +
+	"\bgcs_path\x18\x06 \x01(\tR\agcsPath\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12\x1b\n" +
+	"\tfile_size\x18\b \x01(\x03R\bfileSize\x12;\n" +
+	"\vverified_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\x12\x1f\n" +
+	"\vretry_count\x18\n" +
+	" \x01(\x03R\n" +
+	"retryCount\x12\x1b\n" +
+	"\tcamera_id\x18\v \x01(\tR\bcameraId\x128\n" +
+	"\blocation\x18\f \x01(\v2\x1c.phlx.events.v1.LocationDataR\blocation\"\xbd\x01\n" +
+	"\x0eDetectedObject\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x01R\n" +
+	"confidence\x12>\n" +
+	"\fbounding_box\x18\x03 \x01(\v2\x1b.phlx.events.v1.BoundingBoxR\vboundingBox\x127\n" +
+	"\n" +
+	"attributes\x18\x04 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\"\xf0\x01\n" +
+	"\rDetectedEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x01R\n" +
+	"confidence\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bseverity\x18\x04 \x01(\tR\bseverity\x128\n" +
+	"\blocation\x18\x05 \x01(\v2\x1c.phlx.events.v1.LocationDataR\blocation\x123\n" +
+	"\bmetadata\x18\x06 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\xcb\x02\n" +
+	"\x13AIAnalysisEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x19\n" +
+	"\bframe_id\x18\x03 \x01(\tR\aframeId\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\x01R\n" +
+	"confidence\x128\n" +
+	"\aobjects\x18\x05 \x03(\v2\x1e.phlx.events.v1.DetectedObjectR\aobjects\x125\n" +
+	"\x06events\x18\x06 \x03(\v2\x1d.phlx.events.v1.DetectedEventR\x06events\x123\n" +
+	"\bmetadata\x18\a \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\xba\x04\n" +
+	"\x16EventAnalysisEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x19\n" +
+	"\bframe_id\x18\x03 \x01(\tR\aframeId\x12!\n" +
+	"\fframe_number\x18\x04 \x01(\x03R\vframeNumber\x12#\n" +
+	"\ranalysis_type\x18\x05 \x01(\tR\fanalysisType\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x18\n" +
+	"\asummary\x18\a \x01(\tR\asummary\x12%\n" +
+	"\x0edetected_items\x18\b \x03(\tR\rdetectedItems\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\t \x01(\x01R\n" +
+	"confidence\x12\x1a\n" +
+	"\bseverity\x18\n" +
+	" \x01(\tR\bseverity\x12\x1a\n" +
+	"\bcategory\x18\v \x01(\tR\bcategory\x12\x1b\n" +
+	"\tcamera_id\x18\f \x01(\tR\bcameraId\x128\n" +
+	"\blocation\x18\r \x01(\v2\x1c.phlx.events.v1.LocationDataR\blocation\x123\n" +
+	"\bmetadata\x18\x0e \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12!\n" +
+	"\fraw_response\x18\x0f \x01(\tR\vrawResponse\"\x98\x02\n" +
+	"\x1aSuggestionCreatedEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12#\n" +
+	"\rsuggestion_id\x18\x02 \x01(\tR\fsuggestionId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x04 \x01(\tR\tmissionId\x12#\n" +
+	"\rmission_title\x18\x05 \x01(\tR\fmissionTitle\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x06 \x01(\x01R\n" +
+	"confidence\x12\x1c\n" +
+	"\treasoning\x18\a \x01(\tR\treasoning\"\xe8\x02\n" +
+	"\x17MissionCreatedEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x02 \x01(\tR\tmissionId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\tR\bpriority\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x128\n" +
+	"\blocation\x18\a \x01(\v2\x1c.phlx.events.v1.LocationDataR\blocation\x12\x1b\n" +
+	"\tasset_ids\x18\b \x03(\tR\bassetIds\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\"\x88\x02\n" +
+	"\x19TacticalCommandSuggestion\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vtarget_type\x18\x04 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x12\x1f\n" +
+	"\vtarget_name\x18\x06 \x01(\tR\n" +
+	"targetName\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\tR\bpriority\x12\x1c\n" +
+	"\treasoning\x18\b \x01(\tR\treasoning\"\xb0\x02\n" +
+	"\x1cAIMissionSuggestionEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x02 \x01(\tR\tmissionId\x12#\n" +
+	"\rmission_title\x18\x03 \x01(\tR\fmissionTitle\x12V\n" +
+	"\x11tactical_commands\x18\x04 \x03(\v2).phlx.events.v1.TacticalCommandSuggestionR\x10tacticalCommands\x12\x1a\n" +
+	"\banalysis\x18\x05 \x01(\tR\banalysis\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x06 \x01(\x01R\n" +
+	"confidence\"v\n" +
+	"\x15TacticalCommandTarget\x12\x1f\n" +
+	"\vtarget_type\x18\x01 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x1f\n" +
+	"\vtarget_name\x18\x03 \x01(\tR\n" +
+	"targetName\"o\n" +
+	"\x13TacticalGeoLocation\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x02 \x01(\x01R\x03lng\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"\xd5\x01\n" +
+	"\x0fTacticalGeoArea\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12;\n" +
+	"\x06center\x18\x02 \x01(\v2#.phlx.events.v1.TacticalGeoLocationR\x06center\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x01R\x06radius\x12E\n" +
+	"\vcoordinates\x18\x04 \x03(\v2#.phlx.events.v1.TacticalGeoLocationR\vcoordinates\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\"\xf5\x04\n" +
+	"\x1fTacticalCommandCreatedEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x1d\n" +
+	"\n" +
+	"command_id\x18\x02 \x01(\tR\tcommandId\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x03 \x01(\tR\tmissionId\x12#\n" +
+	"\rmission_title\x18\x04 \x01(\tR\fmissionTitle\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12?\n" +
+	"\atargets\x18\b \x03(\v2%.phlx.events.v1.TacticalCommandTargetR\atargets\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\tR\bpriority\x12%\n" +
+	"\x0ecommand_source\x18\n" +
+	" \x01(\tR\rcommandSource\x12E\n" +
+	"\vdestination\x18\v \x01(\v2#.phlx.events.v1.TacticalGeoLocationR\vdestination\x12K\n" +
+	"\x11area_of_operation\x18\f \x01(\v2\x1f.phlx.events.v1.TacticalGeoAreaR\x0fareaOfOperation\x12\x1c\n" +
+	"\tobjective\x18\r \x01(\tR\tobjective\x12+\n" +
+	"\x11situation_summary\x18\x0e \x01(\tR\x10situationSummary\"\x99\x03\n" +
+	" TacticalCommandResponseEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x1d\n" +
+	"\n" +
+	"command_id\x18\x02 \x01(\tR\tcommandId\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x03 \x01(\tR\tmissionId\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\tR\btargetId\x12\x1f\n" +
+	"\vtarget_type\x18\x05 \x01(\tR\n" +
+	"targetType\x12\x1f\n" +
+	"\vtarget_name\x18\x06 \x01(\tR\n" +
+	"targetName\x12\x1a\n" +
+	"\bdecision\x18\a \x01(\tR\bdecision\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\x12!\n" +
+	"\fresponded_by\x18\t \x01(\tR\vrespondedBy\x12*\n" +
+	"\x11responded_by_name\x18\n" +
+	" \x01(\tR\x0frespondedByName\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\v \x01(\tR\tnewStatus\"\xd8\x02\n" +
+	"\x1eTacticalCommandStatusEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x1d\n" +
+	"\n" +
+	"command_id\x18\x02 \x01(\tR\tcommandId\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x03 \x01(\tR\tmissionId\x12#\n" +
+	"\rcommand_title\x18\x04 \x01(\tR\fcommandTitle\x12\x1d\n" +
+	"\n" +
+	"old_status\x18\x05 \x01(\tR\toldStatus\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\x06 \x01(\tR\tnewStatus\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x12&\n" +
+	"\x0fupdated_by_name\x18\b \x01(\tR\rupdatedByName\x12\x14\n" +
+	"\x05notes\x18\t \x01(\tR\x05notes\"\x8e\x02\n" +
+	"\x1bMissionChatMessageEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x02 \x01(\tR\tmissionId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12\x1b\n" +
+	"\tsender_id\x18\x04 \x01(\tR\bsenderId\x12\x1f\n" +
+	"\vsender_name\x18\x05 \x01(\tR\n" +
+	"senderName\x12\x1f\n" +
+	"\vsender_role\x18\x06 \x01(\tR\n" +
+	"senderRole\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\"I\n" +
+	"\x11MissionTypingUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\"\xc0\x01\n" +
+	"\x1fMissionTypingIndicatorEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x02 \x01(\tR\tmissionId\x12D\n" +
+	"\ftyping_users\x18\x03 \x03(\v2!.phlx.events.v1.MissionTypingUserR\vtypingUsers\"S\n" +
+	"\aFWIInfo\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x03R\x06rating\"\xdb\x01\n" +
+	"\n" +
+	"FireDetail\x12\x17\n" +
+	"\afire_id\x18\x01 \x01(\tR\x06fireId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12)\n" +
+	"\x10satellite_source\x18\x03 \x01(\tR\x0fsatelliteSource\x12\x1a\n" +
+	"\bdistance\x18\x04 \x01(\x01R\bdistance\x12\x17\n" +
+	"\ain_fire\x18\x05 \x01(\bR\x06inFire\x12\x1c\n" +
+	"\tintensity\x18\x06 \x01(\x01R\tintensity\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\a \x01(\tR\n" +
+	"confidence\"\xa6\x01\n" +
+	"\fScoreFactors\x12%\n" +
+	"\x0edistance_score\x18\x01 \x01(\x01R\rdistanceScore\x12'\n" +
+	"\x0fintensity_score\x18\x02 \x01(\x01R\x0eintensityScore\x12)\n" +
+	"\x10confidence_score\x18\x03 \x01(\x01R\x0fconfidenceScore\x12\x1b\n" +
+	"\tfwi_score\x18\x04 \x01(\x01R\bfwiScore\"\xae\x04\n" +
+	"\tFireEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x12#\n" +
+	"\rlocation_name\x18\x03 \x01(\tR\flocationName\x12#\n" +
+	"\rlocation_type\x18\x04 \x01(\tR\flocationType\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x05 \x01(\tR\teventType\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x06 \x01(\tR\triskLevel\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\a \x01(\x01R\triskScore\x120\n" +
+	"\x05fires\x18\b \x03(\v2\x1a.phlx.events.v1.FireDetailR\x05fires\x12)\n" +
+	"\x03fwi\x18\t \x01(\v2\x17.phlx.events.v1.FWIInfoR\x03fwi\x12A\n" +
+	"\rscore_factors\x18\n" +
+	" \x01(\v2\x1c.phlx.events.v1.ScoreFactorsR\fscoreFactors\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\r \x01(\tR\x06status\x12\x1b\n" +
+	"\ttenant_id\x18\x0e \x01(\tR\btenantId\"\xe4\x03\n" +
+	"\x05Alert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x1f\n" +
+	"\vlocation_id\x18\x04 \x01(\tR\n" +
+	"locationId\x12#\n" +
+	"\rlocation_name\x18\x05 \x01(\tR\flocationName\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\"\n" +
+	"\rfire_event_id\x18\a \x01(\tR\vfireEventId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12C\n" +
+	"\x0facknowledged_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0eacknowledgedAt\x12'\n" +
+	"\x0facknowledged_by\x18\f \x01(\tR\x0eacknowledgedBy\x12\x1b\n" +
+	"\ttenant_id\x18\r \x01(\tR\btenantId\"\x87\x01\n" +
+	"\x11FireRiskEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x128\n" +
+	"\n" +
+	"fire_event\x18\x02 \x01(\v2\x19.phlx.events.v1.FireEventR\tfireEvent\"\x96\x03\n" +
+	"\x19FireAlertCreatedEventData\x128\n" +
+	"\n" +
+	"base_event\x18\x01 \x01(\v2\x19.phlx.events.v1.BaseEventR\tbaseEvent\x12\x19\n" +
+	"\balert_id\x18\x02 \x01(\tR\aalertId\x12\"\n" +
+	"\rfire_event_id\x18\x03 \x01(\tR\vfireEventId\x12\x1f\n" +
+	"\vlocation_id\x18\x04 \x01(\tR\n" +
+	"locationId\x12#\n" +
+	"\rlocation_name\x18\x05 \x01(\tR\flocationName\x12\x1a\n" +
+	"\bseverity\x18\x06 \x01(\tR\bseverity\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\b \x01(\x01R\triskScore\x128\n" +
+	"\blocation\x18\t \x01(\v2\x1c.phlx.events.v1.LocationDataR\blocation\x12+\n" +
+	"\x05alert\x18\n" +
+	" \x01(\v2\x15.phlx.events.v1.AlertR\x05alertBFZDgithub.com/ai-project-787/phlx-contracts/go/events/eventspb;eventspbb\x06proto3"
+
+var (
+	file_phlx_events_v1_events_proto_rawDescOnce sync.Once
+	file_phlx_events_v1_events_proto_rawDescData []byte
+)
+
+func file_phlx_events_v1_events_proto_rawDescGZIP() []byte {
+	file_phlx_events_v1_events_proto_rawDescOnce.Do(func() {
+		file_phlx_events_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_phlx_events_v1_events_proto_rawDesc), len(file_phlx_events_v1_events_proto_rawDesc)))
+	})
+	return file_phlx_events_v1_events_proto_rawDescData
+}
+
+var file_phlx_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_phlx_events_v1_events_proto_goTypes = []any{
+	(*BaseEvent)(nil),                        // 0: phlx.events.v1.BaseEvent
+	(*FieldEncryption)(nil),                  // 1: phlx.events.v1.FieldEncryption
+	(*LocationData)(nil),                     // 2: phlx.events.v1.LocationData
+	(*BoundingBox)(nil),                      // 3: phlx.events.v1.BoundingBox
+	(*AssetUpdateEventData)(nil),             // 4: phlx.events.v1.AssetUpdateEventData
+	(*AssetRecallEventData)(nil),             // 5: phlx.events.v1.AssetRecallEventData
+	(*EmergencyNotificationEventData)(nil),   // 6: phlx.events.v1.EmergencyNotificationEventData
+	(*ChatMessageEventData)(nil),             // 7: phlx.events.v1.ChatMessageEventData
+	(*LocationUpdateEventData)(nil),          // 8: phlx.events.v1.LocationUpdateEventData
+	(*VitalsUpdateEventData)(nil),            // 9: phlx.events.v1.VitalsUpdateEventData
+	(*SystemStatusEventData)(nil),            // 10: phlx.events.v1.SystemStatusEventData
+	(*VideoUploadEventData)(nil),             // 11: phlx.events.v1.VideoUploadEventData
+	(*VideoProcessingEventData)(nil),         // 12: phlx.events.v1.VideoProcessingEventData
+	(*FrameExtractionEventData)(nil),         // 13: phlx.events.v1.FrameExtractionEventData
+	(*FrameUploadCompleteEventData)(nil),     // 14: phlx.events.v1.FrameUploadCompleteEventData
+	(*DetectedObject)(nil),                   // 15: phlx.events.v1.DetectedObject
+	(*DetectedEvent)(nil),                    // 16: phlx.events.v1.DetectedEvent
+	(*AIAnalysisEventData)(nil),              // 17: phlx.events.v1.AIAnalysisEventData
+	(*EventAnalysisEventData)(nil),           // 18: phlx.events.v1.EventAnalysisEventData
+	(*SuggestionCreatedEventData)(nil),       // 19: phlx.events.v1.SuggestionCreatedEventData
+	(*MissionCreatedEventData)(nil),          // 20: phlx.events.v1.MissionCreatedEventData
+	(*TacticalCommandSuggestion)(nil),        // 21: phlx.events.v1.TacticalCommandSuggestion
+	(*AIMissionSuggestionEventData)(nil),     // 22: phlx.events.v1.AIMissionSuggestionEventData
+	(*TacticalCommandTarget)(nil),            // 23: phlx.events.v1.TacticalCommandTarget
+	(*TacticalGeoLocation)(nil),              // 24: phlx.events.v1.TacticalGeoLocation
+	(*TacticalGeoArea)(nil),                  // 25: phlx.events.v1.TacticalGeoArea
+	(*TacticalCommandCreatedEventData)(nil),  // 26: phlx.events.v1.TacticalCommandCreatedEventData
+	(*TacticalCommandResponseEventData)(nil), // 27: phlx.events.v1.TacticalCommandResponseEventData
+	(*TacticalCommandStatusEventData)(nil),   // 28: phlx.events.v1.TacticalCommandStatusEventData
+	(*MissionChatMessageEventData)(nil),      // 29: phlx.events.v1.MissionChatMessageEventData
+	(*MissionTypingUser)(nil),                // 30: phlx.events.v1.MissionTypingUser
+	(*MissionTypingIndicatorEventData)(nil),  // 31: phlx.events.v1.MissionTypingIndicatorEventData
+	(*FWIInfo)(nil),                          // 32: phlx.events.v1.FWIInfo
+	(*FireDetail)(nil),                       // 33: phlx.events.v1.FireDetail
+	(*ScoreFactors)(nil),                     // 34: phlx.events.v1.ScoreFactors
+	(*FireEvent)(nil),                        // 35: phlx.events.v1.FireEvent
+	(*Alert)(nil),                            // 36: phlx.events.v1.Alert
+	(*FireRiskEventData)(nil),                // 37: phlx.events.v1.FireRiskEventData
+	(*FireAlertCreatedEventData)(nil),        // 38: phlx.events.v1.FireAlertCreatedEventData
+	(*timestamppb.Timestamp)(nil),            // 39: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                  // 40: google.protobuf.Struct
+}
+var file_phlx_events_v1_events_proto_depIdxs = []int32{
+	39, // 0: phlx.events.v1.BaseEvent.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 1: phlx.events.v1.BaseEvent.encryption:type_name -> phlx.events.v1.FieldEncryption
+	0,  // 2: phlx.events.v1.AssetUpdateEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	2,  // 3: phlx.events.v1.AssetUpdateEventData.location:type_name -> phlx.events.v1.LocationData
+	40, // 4: phlx.events.v1.AssetUpdateEventData.metadata:type_name -> google.protobuf.Struct
+	0,  // 5: phlx.events.v1.AssetRecallEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	39, // 6: phlx.events.v1.AssetRecallEventData.return_by:type_name -> google.protobuf.Timestamp
+	2,  // 7: phlx.events.v1.AssetRecallEventData.location:type_name -> phlx.events.v1.LocationData
+	0,  // 8: phlx.events.v1.EmergencyNotificationEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	2,  // 9: phlx.events.v1.EmergencyNotificationEventData.coordinates:type_name -> phlx.events.v1.LocationData
+	39, // 10: phlx.events.v1.EmergencyNotificationEventData.acknowledged_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: phlx.events.v1.ChatMessageEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	0,  // 12: phlx.events.v1.LocationUpdateEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	2,  // 13: phlx.events.v1.LocationUpdateEventData.location:type_name -> phlx.events.v1.LocationData
+	0,  // 14: phlx.events.v1.VitalsUpdateEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	0,  // 15: phlx.events.v1.SystemStatusEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	40, // 16: phlx.events.v1.SystemStatusEventData.metadata:type_name -> google.protobuf.Struct
+	0,  // 17: phlx.events.v1.VideoUploadEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	2,  // 18: phlx.events.v1.VideoUploadEventData.location:type_name -> phlx.events.v1.LocationData
+	0,  // 19: phlx.events.v1.VideoProcessingEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	39, // 20: phlx.events.v1.VideoProcessingEventData.started_at:type_name -> google.protobuf.Timestamp
+	39, // 21: phlx.events.v1.VideoProcessingEventData.completed_at:type_name -> google.protobuf.Timestamp
+	0,  // 22: phlx.events.v1.FrameExtractionEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	2,  // 23: phlx.events.v1.FrameExtractionEventData.location:type_name -> phlx.events.v1.LocationData
+	0,  // 24: phlx.events.v1.FrameUploadCompleteEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	39, // 25: phlx.events.v1.FrameUploadCompleteEventData.verified_at:type_name -> google.protobuf.Timestamp
+	2,  // 26: phlx.events.v1.FrameUploadCompleteEventData.location:type_name -> phlx.events.v1.LocationData
+	3,  // 27: phlx.events.v1.DetectedObject.bounding_box:type_name -> phlx.events.v1.BoundingBox
+	40, // 28: phlx.events.v1.DetectedObject.attributes:type_name -> google.protobuf.Struct
+	2,  // 29: phlx.events.v1.DetectedEvent.location:type_name -> phlx.events.v1.LocationData
+	40, // 30: phlx.events.v1.DetectedEvent.metadata:type_name -> google.protobuf.Struct
+	0,  // 31: phlx.events.v1.AIAnalysisEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	15, // 32: phlx.events.v1.AIAnalysisEventData.objects:type_name -> phlx.events.v1.DetectedObject
+	16, // 33: phlx.events.v1.AIAnalysisEventData.events:type_name -> phlx.events.v1.DetectedEvent
+	40, // 34: phlx.events.v1.AIAnalysisEventData.metadata:type_name -> google.protobuf.Struct
+	0,  // 35: phlx.events.v1.EventAnalysisEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	2,  // 36: phlx.events.v1.EventAnalysisEventData.location:type_name -> phlx.events.v1.LocationData
+	40, // 37: phlx.events.v1.EventAnalysisEventData.metadata:type_name -> google.protobuf.Struct
+	0,  // 38: phlx.events.v1.SuggestionCreatedEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	0,  // 39: phlx.events.v1.MissionCreatedEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	2,  // 40: phlx.events.v1.MissionCreatedEventData.location:type_name -> phlx.events.v1.LocationData
+	0,  // 41: phlx.events.v1.AIMissionSuggestionEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	21, // 42: phlx.events.v1.AIMissionSuggestionEventData.tactical_commands:type_name -> phlx.events.v1.TacticalCommandSuggestion
+	24, // 43: phlx.events.v1.TacticalGeoArea.center:type_name -> phlx.events.v1.TacticalGeoLocation
+	24, // 44: phlx.events.v1.TacticalGeoArea.coordinates:type_name -> phlx.events.v1.TacticalGeoLocation
+	0,  // 45: phlx.events.v1.TacticalCommandCreatedEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	23, // 46: phlx.events.v1.TacticalCommandCreatedEventData.targets:type_name -> phlx.events.v1.TacticalCommandTarget
+	24, // 47: phlx.events.v1.TacticalCommandCreatedEventData.destination:type_name -> phlx.events.v1.TacticalGeoLocation
+	25, // 48: phlx.events.v1.TacticalCommandCreatedEventData.area_of_operation:type_name -> phlx.events.v1.TacticalGeoArea
+	0,  // 49: phlx.events.v1.TacticalCommandResponseEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	0,  // 50: phlx.events.v1.TacticalCommandStatusEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	0,  // 51: phlx.events.v1.MissionChatMessageEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	0,  // 52: phlx.events.v1.MissionTypingIndicatorEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	30, // 53: phlx.events.v1.MissionTypingIndicatorEventData.typing_users:type_name -> phlx.events.v1.MissionTypingUser
+	33, // 54: phlx.events.v1.FireEvent.fires:type_name -> phlx.events.v1.FireDetail
+	32, // 55: phlx.events.v1.FireEvent.fwi:type_name -> phlx.events.v1.FWIInfo
+	34, // 56: phlx.events.v1.FireEvent.score_factors:type_name -> phlx.events.v1.ScoreFactors
+	39, // 57: phlx.events.v1.FireEvent.created_at:type_name -> google.protobuf.Timestamp
+	39, // 58: phlx.events.v1.FireEvent.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 59: phlx.events.v1.Alert.created_at:type_name -> google.protobuf.Timestamp
+	39, // 60: phlx.events.v1.Alert.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 61: phlx.events.v1.Alert.acknowledged_at:type_name -> google.protobuf.Timestamp
+	0,  // 62: phlx.events.v1.FireRiskEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	35, // 63: phlx.events.v1.FireRiskEventData.fire_event:type_name -> phlx.events.v1.FireEvent
+	0,  // 64: phlx.events.v1.FireAlertCreatedEventData.base_event:type_name -> phlx.events.v1.BaseEvent
+	2,  // 65: phlx.events.v1.FireAlertCreatedEventData.location:type_name -> phlx.events.v1.LocationData
+	36, // 66: phlx.events.v1.FireAlertCreatedEventData.alert:type_name -> phlx.events.v1.Alert
+	67, // [67:67] is the sub-list for method output_type
+	67, // [67:67] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
+}
+
+func init() { file_phlx_events_v1_events_proto_init() }
+func file_phlx_events_v1_events_proto_init() {
+	if File_phlx_events_v1_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_phlx_events_v1_events_proto_rawDesc), len(file_phlx_events_v1_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   39,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_phlx_events_v1_events_proto_goTypes,
+		DependencyIndexes: file_phlx_events_v1_events_proto_depIdxs,
+		MessageInfos:      file_phlx_events_v1_events_proto_msgTypes,
+	}.Build()
+	File_phlx_events_v1_events_proto = out.File
+	file_phlx_events_v1_events_proto_goTypes = nil
+	file_phlx_events_v1_events_proto_depIdxs = nil
+}
@@ -0,0 +1,92 @@
+// Package filekeys loads key-encryption keys for events.FieldEncryptor from a directory,
+// for development and tests. Production services use a KMS-backed events.KeyProvider.
+//
+// Each key is a file <key ID>.key holding 32 random bytes, base64-encoded. The key whose
+// ID sorts last wraps new data keys, so naming keys by date makes rotation a matter of
+// generating a new one:
+//
+//	filekeys.Generate("/etc/phlx/keys", "2025-03-14")
+//	keys, err := filekeys.Load("/etc/phlx/keys")
+//	enc := events.NewFieldEncryptor(keys)
+//
+// Consumers that must not read health data are simply not given the directory.
+package filekeys
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+)
+
+const (
+	keySize = 32
+	keyExt  = ".key"
+)
+
+// keyIDPattern keeps key IDs usable as file names
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// Load reads every key in dir into a keyring whose current key is the last key ID
+func Load(dir string) (*events.Keyring, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("filekeys: %w", err)
+	}
+	keys := map[string][]byte{}
+	var ids []string
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), keyExt)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("filekeys: %w", err)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil || len(key) != keySize {
+			return nil, fmt.Errorf("filekeys: %s must hold %d base64-encoded bytes", entry.Name(), keySize)
+		}
+		keys[id] = key
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("filekeys: no %s files in %s", keyExt, dir)
+	}
+	sort.Strings(ids)
+	return events.NewKeyring(ids[len(ids)-1], keys)
+}
+
+// Generate writes a new random key to dir; it does not overwrite an existing key
+func Generate(dir, keyID string) error {
+	if !keyIDPattern.MatchString(keyID) {
+		return fmt.Errorf("filekeys: invalid key ID %q", keyID)
+	}
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return fmt.Errorf("filekeys: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("filekeys: %w", err)
+	}
+	f, err := os.OpenFile(filepath.Join(dir, keyID+keyExt), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if errors.Is(err, os.ErrExist) {
+		return fmt.Errorf("filekeys: key %s already exists", keyID)
+	}
+	if err != nil {
+		return fmt.Errorf("filekeys: %w", err)
+	}
+	if _, err := f.WriteString(base64.StdEncoding.EncodeToString(key) + "\n"); err != nil {
+		f.Close()
+		return fmt.Errorf("filekeys: %w", err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,74 @@
+package filekeys
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+)
+
+func TestLoadAndRotate(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	if _, err := Load(dir); err == nil {
+		t.Error("Load of an empty directory succeeded")
+	}
+	if err := Generate(dir, "2025-03-01"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Generate(dir, "2025-03-01"); err == nil {
+		t.Error("Generate overwrote a key")
+	}
+
+	keys, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vitals := &events.VitalsUpdateEventData{
+		BaseEvent:   events.BaseEvent{ID: "evt-1", Type: events.VitalsUpdateEvent, Source: "test"},
+		PersonnelID: "p-7", PulseRate: 72, OxygenLevel: 98,
+	}
+	if err := events.NewFieldEncryptor(keys).Encrypt(ctx, vitals); err != nil {
+		t.Fatal(err)
+	}
+	if vitals.Encryption.KeyID != "2025-03-01" {
+		t.Errorf("wrapped with %q", vitals.Encryption.KeyID)
+	}
+
+	if err := Generate(dir, "2025-03-14"); err != nil {
+		t.Fatal(err)
+	}
+	rotated, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc := events.NewFieldEncryptor(rotated)
+	if err := enc.Rewrap(ctx, vitals); err != nil {
+		t.Fatal(err)
+	}
+	if vitals.Encryption.KeyID != "2025-03-14" {
+		t.Errorf("rewrapped with %q", vitals.Encryption.KeyID)
+	}
+	if err := enc.Decrypt(ctx, vitals); err != nil || vitals.PulseRate != 72 {
+		t.Errorf("Decrypt() = %v, pulse %d", err, vitals.PulseRate)
+	}
+}
+
+func TestInvalidKeys(t *testing.T) {
+	dir := t.TempDir()
+	if err := Generate(dir, "../escape"); err == nil {
+		t.Error("Generate accepted a key ID outside the directory")
+	}
+	if err := os.WriteFile(filepath.Join(dir, "short.key"), []byte("c2hvcnQ=\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(dir); err == nil {
+		t.Error("Load accepted a short key")
+	}
+	if _, err := Load(filepath.Join(dir, "missing")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Load of a missing directory: %v", err)
+	}
+}
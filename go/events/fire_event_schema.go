@@ -1,5 +1,23 @@
 package events
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// Fire risk event types carried by the legacy FireEventSchema envelope
+const (
+	FireRiskDetectedEvent EventType = "fire.risk.detected"
+	FireRiskUpdatedEvent  EventType = "fire.risk.updated"
+	FireRiskClearedEvent  EventType = "fire.risk.cleared"
+)
+
+// FireEventSchemaVersion is the current version of FireEventSchema
+const FireEventSchemaVersion = "1.0"
+
 // FireEventSchema defines the Kafka message schema for fire events
 type FireEventSchema struct {
 	SchemaVersion string      `json:"schema_version"` // "1.0"
@@ -7,3 +25,171 @@ type FireEventSchema struct {
 	Timestamp     string      `json:"timestamp"`      // ISO 8601
 	Payload       interface{} `json:"payload"`        // models.FireEvent
 }
+
+// FireEventMessage is FireEventSchema with typed fields; both have the same JSON
+// encoding, so a fire event message decodes straight into either
+type FireEventMessage struct {
+	SchemaVersion string           `json:"schema_version"` // "1.0"
+	EventType     EventType        `json:"event_type"`     // FireRiskDetectedEvent, FireRiskUpdatedEvent, FireRiskClearedEvent
+	Timestamp     time.Time        `json:"timestamp"`      // ISO 8601
+	Payload       models.FireEvent `json:"payload"`
+}
+
+// FireRiskEventData represents a fire risk change in BaseEvent form
+type FireRiskEventData struct {
+	BaseEvent
+	FireEvent models.FireEvent `json:"fireEvent"`
+}
+
+// FireAlertCreatedEventData represents an alert raised from a fire event
+type FireAlertCreatedEventData struct {
+	BaseEvent
+	AlertID      string        `json:"alertId"`
+	FireEventID  string        `json:"fireEventId"`
+	LocationID   string        `json:"locationId"`
+	LocationName string        `json:"locationName"`
+	Severity     string        `json:"severity"`
+	Message      string        `json:"message"`
+	RiskScore    float64       `json:"riskScore"`
+	Location     *LocationData `json:"location,omitempty"`
+	Alert        models.Alert  `json:"alert"`
+}
+
+// FireEventTypes returns the event types carried by FireEventSchema
+func FireEventTypes() []EventType {
+	return []EventType{
+		FireRiskDetectedEvent,
+		FireRiskUpdatedEvent,
+		FireRiskClearedEvent,
+	}
+}
+
+// IsFireEventType checks if an event type belongs to the fire risk pipeline
+func IsFireEventType(eventType EventType) bool {
+	for _, t := range FireEventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// NewFireAlertCreatedEventData fills the alert summary fields from the alert and,
+// when available, the fire event that raised it
+func NewFireAlertCreatedEventData(base BaseEvent, alert models.Alert, fireEvent *models.FireEvent) *FireAlertCreatedEventData {
+	base.Type = FireAlertCreatedEvent
+	e := &FireAlertCreatedEventData{
+		BaseEvent:    base,
+		AlertID:      alert.ID,
+		FireEventID:  alert.FireEventID,
+		LocationID:   alert.LocationID,
+		LocationName: alert.LocationName,
+		Severity:     alert.Severity,
+		Message:      alert.Message,
+		Alert:        alert,
+	}
+	if fireEvent != nil {
+		e.RiskScore = fireEvent.RiskScore
+		if e.FireEventID == "" {
+			e.FireEventID = fireEvent.ID
+		}
+	}
+	return e
+}
+
+// Typed converts the envelope to a FireEventMessage through its JSON encoding
+func (s *FireEventSchema) Typed() (*FireEventMessage, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var m FireEventMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("events: fire event message: %w", err)
+	}
+	return &m, nil
+}
+
+// ToEventData converts the legacy envelope into a BaseEvent-style payload.
+// The event ID is derived from the fire event, type and timestamp so
+// re-converting the same message yields the same ID.
+func (m *FireEventMessage) ToEventData(source string) (*FireRiskEventData, error) {
+	if !IsFireEventType(m.EventType) {
+		return nil, fmt.Errorf("events: %q is not a fire risk event type", m.EventType)
+	}
+	return &FireRiskEventData{
+		BaseEvent: BaseEvent{
+			ID:            fmt.Sprintf("%s:%s:%d", m.Payload.ID, m.EventType, m.Timestamp.UnixNano()),
+			Type:          m.EventType,
+			Timestamp:     m.Timestamp,
+			Source:        source,
+			SchemaVersion: m.SchemaVersion,
+		},
+		FireEvent: m.Payload,
+	}, nil
+}
+
+// FireEventMessageFrom converts a BaseEvent-style fire risk payload back into the legacy envelope
+func FireEventMessageFrom(e *FireRiskEventData) (*FireEventMessage, error) {
+	if !IsFireEventType(e.Type) {
+		return nil, fmt.Errorf("events: %q is not a fire risk event type", e.Type)
+	}
+	version := e.SchemaVersion
+	if version == "" {
+		version = FireEventSchemaVersion
+	}
+	return &FireEventMessage{
+		SchemaVersion: version,
+		EventType:     e.Type,
+		Timestamp:     e.Timestamp,
+		Payload:       e.FireEvent,
+	}, nil
+}
+
+// PartitionKey returns the monitored location ID so risk changes for one location stay ordered
+func (e *FireRiskEventData) PartitionKey() string { return e.FireEvent.LocationID }
+
+// PartitionKey returns the alert's location ID
+func (e *FireAlertCreatedEventData) PartitionKey() string { return e.LocationID }
+
+// Validate checks the fire event reference, risk level and 0-100 risk score
+func (e *FireRiskEventData) Validate() error {
+	v := &validator{}
+	v.required("id", e.ID)
+	if !IsFireEventType(e.Type) {
+		v.fail("type", "must be a fire risk event type, got %q", e.Type)
+	}
+	v.required("source", e.Source)
+	v.trace(e.BaseEvent)
+	v.sequence(e.BaseEvent)
+	v.required("fireEvent.id", e.FireEvent.ID)
+	v.required("fireEvent.location_id", e.FireEvent.LocationID)
+	v.oneOf("fireEvent.risk_level", e.FireEvent.RiskLevel, validRiskLevels)
+	v.between("fireEvent.risk_score", e.FireEvent.RiskScore, 0, 100)
+	return v.err()
+}
+
+// Validate checks the alert reference, severity and risk score
+func (e *FireAlertCreatedEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, FireAlertCreatedEvent)
+	v.required("alertId", e.AlertID)
+	v.required("locationId", e.LocationID)
+	v.oneOf("severity", e.Severity, validSeverities)
+	v.between("riskScore", e.RiskScore, 0, 100)
+	v.location("location", e.Location)
+	if e.Alert.ID != e.AlertID {
+		v.fail("alert.id", "must match alertId %q, got %q", e.AlertID, e.Alert.ID)
+	}
+	return v.err()
+}
+
+// validRiskLevels lists FireEvent.RiskLevel values
+var validRiskLevels = []string{"none", "low", "medium", "high", "critical"}
+
+func init() {
+	MustRegister[FireAlertCreatedEventData](FireAlertCreatedEvent)
+	for _, t := range FireEventTypes() {
+		MustRegister[FireRiskEventData](t)
+	}
+}
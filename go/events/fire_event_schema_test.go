@@ -0,0 +1,200 @@
+package events
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+const legacyFireMessage = `{
+	"schema_version": "1.0",
+	"event_type": "fire.risk.updated",
+	"timestamp": "2025-07-02T13:45:00.123Z",
+	"payload": {
+		"id": "fe-1",
+		"location_id": "loc-7",
+		"location_name": "North depot",
+		"event_type": "updated",
+		"risk_level": "high",
+		"risk_score": 72.5,
+		"fires": [],
+		"created_at": "2025-07-02T13:00:00Z",
+		"updated_at": "2025-07-02T13:45:00Z",
+		"status": "active"
+	}
+}`
+
+func TestFireEventMessageDecodesTyped(t *testing.T) {
+	var m FireEventMessage
+	if err := json.Unmarshal([]byte(legacyFireMessage), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.EventType != FireRiskUpdatedEvent || !m.Timestamp.Equal(time.Date(2025, 7, 2, 13, 45, 0, 123e6, time.UTC)) {
+		t.Errorf("envelope %s at %v", m.EventType, m.Timestamp)
+	}
+	if m.Payload.ID != "fe-1" || m.Payload.RiskScore != 72.5 || !m.Payload.CreatedAt.Equal(time.Date(2025, 7, 2, 13, 0, 0, 0, time.UTC)) {
+		t.Errorf("payload %+v", m.Payload)
+	}
+
+	// The legacy struct keeps its wire types and converts to the typed one
+	var legacy FireEventSchema
+	if err := json.Unmarshal([]byte(legacyFireMessage), &legacy); err != nil {
+		t.Fatal(err)
+	}
+	if legacy.EventType != "fire.risk.updated" || legacy.Timestamp != "2025-07-02T13:45:00.123Z" {
+		t.Errorf("legacy envelope %+v", legacy)
+	}
+	typed, err := legacy.Typed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*typed, m) {
+		t.Errorf("Typed() = %+v, want %+v", *typed, m)
+	}
+	legacy.Timestamp = "yesterday"
+	if _, err := legacy.Typed(); err == nil {
+		t.Error("Typed() accepted a malformed timestamp")
+	}
+}
+
+func TestFireEventMessageConversion(t *testing.T) {
+	var s FireEventMessage
+	if err := json.Unmarshal([]byte(legacyFireMessage), &s); err != nil {
+		t.Fatal(err)
+	}
+	e, err := s.ToEventData("fire-monitor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Type != FireRiskUpdatedEvent || e.Source != "fire-monitor" || !e.Timestamp.Equal(s.Timestamp) || e.SchemaVersion != "1.0" {
+		t.Errorf("converted metadata %+v", e.BaseEvent)
+	}
+	if err := e.Validate(); err != nil {
+		t.Errorf("converted event: %v", err)
+	}
+	if e.PartitionKey() != "loc-7" {
+		t.Errorf("PartitionKey() = %q, want loc-7", e.PartitionKey())
+	}
+	if again, _ := s.ToEventData("fire-monitor"); again.ID != e.ID {
+		t.Errorf("IDs differ across conversions: %s, %s", e.ID, again.ID)
+	}
+
+	back, err := FireEventMessageFrom(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*back, s) {
+		t.Errorf("round trip %+v, want %+v", *back, s)
+	}
+
+	e.SchemaVersion = ""
+	if back, _ := FireEventMessageFrom(e); back.SchemaVersion != FireEventSchemaVersion {
+		t.Errorf("schema version %q, want %q", back.SchemaVersion, FireEventSchemaVersion)
+	}
+}
+
+func TestFireEventMessageRejectsOtherTypes(t *testing.T) {
+	for _, eventType := range []EventType{FireAlertCreatedEvent, MissionCreated, "fire.risk.unknown", ""} {
+		s := &FireEventMessage{SchemaVersion: "1.0", EventType: eventType}
+		if _, err := s.ToEventData("test"); err == nil {
+			t.Errorf("ToEventData accepted %q", eventType)
+		}
+		e := &FireRiskEventData{BaseEvent: BaseEvent{Type: eventType}}
+		if _, err := FireEventMessageFrom(e); err == nil {
+			t.Errorf("FireEventMessageFrom accepted %q", eventType)
+		}
+		if IsFireEventType(eventType) {
+			t.Errorf("IsFireEventType(%q) = true", eventType)
+		}
+	}
+}
+
+func TestNewFireAlertCreatedEventData(t *testing.T) {
+	alert := models.Alert{ID: "al-1", LocationID: "loc-7", LocationName: "North depot", Severity: "high", Message: "Fire within 2 km"}
+	referenced := alert
+	referenced.FireEventID = "fe-0"
+	fire := &models.FireEvent{ID: "fe-1", RiskScore: 81}
+	for _, tt := range []struct {
+		name            string
+		alert           models.Alert
+		fire            *models.FireEvent
+		wantFireEventID string
+		wantRiskScore   float64
+	}{
+		{"alert only", alert, nil, "", 0},
+		{"with fire event", alert, fire, "fe-1", 81},
+		{"alert reference wins", referenced, fire, "fe-0", 81},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewFireAlertCreatedEventData(validBase(MissionCreated), tt.alert, tt.fire)
+			if e.Type != FireAlertCreatedEvent {
+				t.Errorf("type %s, want %s", e.Type, FireAlertCreatedEvent)
+			}
+			if e.AlertID != "al-1" || e.LocationID != "loc-7" || e.LocationName != "North depot" || e.Severity != "high" ||
+				e.Message != "Fire within 2 km" || e.Alert.ID != "al-1" || e.PartitionKey() != "loc-7" {
+				t.Errorf("alert summary %+v", e)
+			}
+			if e.FireEventID != tt.wantFireEventID || e.RiskScore != tt.wantRiskScore {
+				t.Errorf("fire event %q score %g, want %q %g", e.FireEventID, e.RiskScore, tt.wantFireEventID, tt.wantRiskScore)
+			}
+			if err := e.Validate(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func validFireRisk() *FireRiskEventData {
+	return &FireRiskEventData{
+		BaseEvent: validBase(FireRiskDetectedEvent),
+		FireEvent: models.FireEvent{ID: "fe-1", LocationID: "loc-7", RiskLevel: "medium", RiskScore: 40},
+	}
+}
+
+func validFireAlert() *FireAlertCreatedEventData {
+	return &FireAlertCreatedEventData{
+		BaseEvent: validBase(FireAlertCreatedEvent), AlertID: "al-1", LocationID: "loc-7", Severity: "critical", RiskScore: 95,
+		Location: &LocationData{Latitude: 38.7, Longitude: -9.1}, Alert: models.Alert{ID: "al-1"},
+	}
+}
+
+// TestFireValidateRules has one failing case per rule of the fire payloads
+func TestFireValidateRules(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		payload Validator
+		want    []string
+	}{
+		{"risk valid", validFireRisk(), nil},
+		{"risk cleared", edit(validFireRisk(), func(e *FireRiskEventData) {
+			e.Type, e.FireEvent.RiskLevel, e.FireEvent.RiskScore = FireRiskClearedEvent, "none", 0
+		}), nil},
+		{"risk without id", edit(validFireRisk(), func(e *FireRiskEventData) { e.ID = "" }), []string{"id"}},
+		{"risk type", edit(validFireRisk(), func(e *FireRiskEventData) { e.Type = FireAlertCreatedEvent }), []string{"type"}},
+		{"risk without source", edit(validFireRisk(), func(e *FireRiskEventData) { e.Source = "" }), []string{"source"}},
+		{"risk tracestate without traceparent", edit(validFireRisk(), func(e *FireRiskEventData) { e.TraceState = "vendor=1" }), []string{"tracestate"}},
+		{"risk sequence without aggregate", edit(validFireRisk(), func(e *FireRiskEventData) { e.Sequence = 2 }), []string{"aggregateId"}},
+		{"risk without fire event", edit(validFireRisk(), func(e *FireRiskEventData) { e.FireEvent.ID = "" }), []string{"fireEvent.id"}},
+		{"risk without location", edit(validFireRisk(), func(e *FireRiskEventData) { e.FireEvent.LocationID = "" }), []string{"fireEvent.location_id"}},
+		{"risk level", edit(validFireRisk(), func(e *FireRiskEventData) { e.FireEvent.RiskLevel = "extreme" }), []string{"fireEvent.risk_level"}},
+		{"risk score", edit(validFireRisk(), func(e *FireRiskEventData) { e.FireEvent.RiskScore = 100.5 }), []string{"fireEvent.risk_score"}},
+
+		{"alert valid", validFireAlert(), nil},
+		{"alert type", edit(validFireAlert(), func(e *FireAlertCreatedEventData) { e.Type = FireRiskDetectedEvent }), []string{"type"}},
+		{"alert without id", edit(validFireAlert(), func(e *FireAlertCreatedEventData) { e.AlertID = "" }), []string{"alertId", "alert.id"}},
+		{"alert without location", edit(validFireAlert(), func(e *FireAlertCreatedEventData) { e.LocationID = "" }), []string{"locationId"}},
+		{"alert severity", edit(validFireAlert(), func(e *FireAlertCreatedEventData) { e.Severity = "extreme" }), []string{"severity"}},
+		{"alert risk score", edit(validFireAlert(), func(e *FireAlertCreatedEventData) { e.RiskScore = -1 }), []string{"riskScore"}},
+		{"alert latitude", edit(validFireAlert(), func(e *FireAlertCreatedEventData) { e.Location.Latitude = 91 }), []string{"location.latitude"}},
+		{"alert mismatch", edit(validFireAlert(), func(e *FireAlertCreatedEventData) { e.Alert.ID = "al-2" }), []string{"alert.id"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := invalidFields(t, tt.payload.Validate()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("invalid fields %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
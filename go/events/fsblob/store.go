@@ -0,0 +1,100 @@
+// Package fsblob implements events.BlobStore on a local directory, for claim-checked
+// payloads in development and on single hosts or shared volumes.
+//
+// A blob is stored at <dir>/sha256/<first two hex digits>/<hex digest>. Writes go to a
+// temporary file that is renamed into place, so readers never see a partial blob.
+package fsblob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+)
+
+// Store is an events.BlobStore backed by a directory
+type Store struct {
+	dir string
+}
+
+var _ events.BlobStore = (*Store)(nil)
+
+// New returns a store under dir; directories are created on the first Put
+func New(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// path returns the file of a key, rejecting keys that are not content addresses so a
+// key can never name a file outside the store
+func (s *Store) path(key string) (string, error) {
+	if !events.IsValidBlobKey(key) {
+		return "", fmt.Errorf("fsblob: invalid key %q", key)
+	}
+	algorithm, digest, _ := strings.Cut(key, ":")
+	return filepath.Join(s.dir, algorithm, digest[:2], digest), nil
+}
+
+// Put implements events.BlobStore. A blob already stored is not written again.
+func (s *Store) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("fsblob: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("fsblob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("fsblob: write %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("fsblob: write %s: %w", key, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("fsblob: write %s: %w", key, err)
+	}
+	return nil
+}
+
+// Get implements events.BlobStore
+func (s *Store) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", events.ErrBlobNotFound, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fsblob: read %s: %w", key, err)
+	}
+	return data, nil
+}
+
+// Delete removes a blob, e.g. once every consumer has read the records referencing it.
+// Deleting an unknown key is not an error.
+func (s *Store) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("fsblob: delete %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package fsblob
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s := New(dir)
+	data := []byte(`"raw model response"`)
+	key := events.BlobKey(data)
+
+	if _, err := s.Get(ctx, key); !errors.Is(err, events.ErrBlobNotFound) {
+		t.Errorf("Get before Put: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := s.Put(ctx, key, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := s.Get(ctx, key)
+	if err != nil || string(got) != string(data) {
+		t.Errorf("Get() = %s, %v", got, err)
+	}
+	digest := key[len("sha256:"):]
+	if _, err := os.Stat(filepath.Join(dir, "sha256", digest[:2], digest)); err != nil {
+		t.Errorf("blob file: %v", err)
+	}
+
+	if err := s.Delete(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get(ctx, key); !errors.Is(err, events.ErrBlobNotFound) {
+		t.Errorf("Get after Delete: %v", err)
+	}
+	if err := s.Put(ctx, "sha256:../../escape", data); err == nil {
+		t.Error("Put accepted a key outside the store")
+	}
+}
+
+func TestClaimCheckWithStore(t *testing.T) {
+	ctx := context.Background()
+	events.SetSource("fsblob-test")
+	e := events.NewEventAnalysis(events.EventAnalysisEventData{VideoID: "video-1", RawResponse: string(make([]byte, 8192))})
+	msg, err := events.EncodeMessage(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc := events.NewClaimCheck(New(t.TempDir()), 4096)
+	if err := cc.Offload(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+	if err := cc.Rehydrate(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := events.DecodeMessage(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := decoded.(*events.EventAnalysisEventData).RawResponse; got != e.RawResponse {
+		t.Errorf("rehydrated rawResponse has %d bytes", len(got))
+	}
+}
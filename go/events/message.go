@@ -0,0 +1,37 @@
+package events
+
+// KafkaHeader is a Kafka record header
+type KafkaHeader struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// KafkaMessage is the client-agnostic shape of a Kafka record, so the contracts do not
+// depend on a particular Kafka library. Services copy it to and from their client's type.
+type KafkaMessage struct {
+	Topic   string        `json:"topic,omitempty"`
+	Key     []byte        `json:"key,omitempty"`
+	Value   []byte        `json:"value"`
+	Headers []KafkaHeader `json:"headers,omitempty"`
+}
+
+// Header returns the value of the first header with the key
+func (m *KafkaMessage) Header(key string) (string, bool) {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value), true
+		}
+	}
+	return "", false
+}
+
+// SetHeader replaces the header with the key, or appends it
+func (m *KafkaMessage) SetHeader(key, value string) {
+	for i, h := range m.Headers {
+		if h.Key == key {
+			m.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	m.Headers = append(m.Headers, KafkaHeader{Key: key, Value: []byte(value)})
+}
@@ -0,0 +1,86 @@
+// Package mongodedup implements events.DedupStore on a MongoDB collection, so every
+// replica of a consumer shares one record of processed event IDs.
+//
+// Each processed ID is a document {_id: <event ID>, seenAt, expiresAt}. While a handler
+// runs, expiresAt is the end of the Deduplicator's lease; Confirm moves it to the end of
+// the window. A TTL index on expiresAt lets MongoDB delete expired IDs; until its
+// background task runs, expired documents are treated as absent.
+package mongodedup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+)
+
+// Store is an events.DedupStore backed by a MongoDB collection
+type Store struct {
+	coll *mongo.Collection
+}
+
+var _ events.DedupStore = (*Store)(nil)
+
+// New returns a store using the collection, e.g. db.Collection("processed_events_audit").
+// Use one collection per consumer group: IDs are shared by every consumer of it.
+func New(coll *mongo.Collection) *Store {
+	return &Store{coll: coll}
+}
+
+// EnsureIndexes creates the TTL index that expires processed IDs
+func (s *Store) EnsureIndexes(ctx context.Context) error {
+	_, err := s.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+		Options: options.Index().SetName("expiresAt_ttl").SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return fmt.Errorf("mongodedup: create TTL index: %w", err)
+	}
+	return nil
+}
+
+// MarkSeen implements events.DedupStore with a single upsert. The filter only matches
+// an expired document, so a live document makes the upsert insert a second _id, which
+// fails with a duplicate key error: the ID was seen.
+func (s *Store) MarkSeen(ctx context.Context, id string, now, expiresAt time.Time) (bool, error) {
+	_, err := s.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "expiresAt": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"seenAt": now, "expiresAt": expiresAt}},
+		options.Update().SetUpsert(true),
+	)
+	switch {
+	case err == nil:
+		return false, nil
+	case mongo.IsDuplicateKeyError(err):
+		return true, nil
+	default:
+		return false, fmt.Errorf("mongodedup: mark %q: %w", id, err)
+	}
+}
+
+// Confirm implements events.DedupStore. It upserts, so an ID whose claim expired and was
+// deleted while the handler ran is recorded again.
+func (s *Store) Confirm(ctx context.Context, id string, expiresAt time.Time) error {
+	_, err := s.coll.UpdateOne(ctx,
+		bson.M{"_id": id},
+		bson.M{"$set": bson.M{"expiresAt": expiresAt}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("mongodedup: confirm %q: %w", id, err)
+	}
+	return nil
+}
+
+// Forget implements events.DedupStore
+func (s *Store) Forget(ctx context.Context, id string) error {
+	if _, err := s.coll.DeleteOne(ctx, bson.M{"_id": id}); err != nil {
+		return fmt.Errorf("mongodedup: forget %q: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package mongodedup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+)
+
+var (
+	now     = time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+	later   = now.Add(time.Hour)
+	dupKey  = mtest.WriteError{Index: 0, Code: 11000, Message: "E11000 duplicate key error"}
+	timeout = mtest.WriteError{Index: 0, Code: 50, Message: "operation exceeded time limit"}
+)
+
+// mockStore runs f against a Store on a mock deployment that answers with responses
+func mockStore(t *testing.T, f func(mt *mtest.T, s *Store), responses ...bson.D) {
+	mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock)).Run("mock", func(mt *mtest.T) {
+		mt.AddMockResponses(responses...)
+		f(mt, New(mt.Coll))
+	})
+}
+
+// update returns the single update statement of a started update command
+func update(mt *mtest.T) (q, u bson.Raw, upsert bool) {
+	mt.Helper()
+	started := mt.GetStartedEvent()
+	if started == nil || started.CommandName != "update" {
+		mt.Fatalf("started %+v, want an update", started)
+	}
+	stmt := started.Command.Lookup("updates").Array().Index(0).Value().Document()
+	upsert, _ = stmt.Lookup("upsert").BooleanOK()
+	return stmt.Lookup("q").Document(), stmt.Lookup("u").Document(), upsert
+}
+
+// timeAt returns the time at a dotted path of a document
+func timeAt(doc bson.Raw, path ...string) time.Time {
+	return doc.Lookup(path...).Time().UTC()
+}
+
+func TestMarkSeen(t *testing.T) {
+	ctx := context.Background()
+	mockStore(t, func(mt *mtest.T, s *Store) {
+		if seen, err := s.MarkSeen(ctx, "evt-1", now, later); err != nil || seen {
+			mt.Fatalf("first MarkSeen = %v, %v", seen, err)
+		}
+		q, u, upsert := update(mt)
+		if q.Lookup("_id").StringValue() != "evt-1" || !timeAt(q, "expiresAt", "$lte").Equal(now) || !upsert {
+			mt.Errorf("filter %s, upsert %v", q, upsert)
+		}
+		if !timeAt(u, "$set", "expiresAt").Equal(later) || !timeAt(u, "$set", "seenAt").Equal(now) {
+			mt.Errorf("update %s", u)
+		}
+
+		if seen, err := s.MarkSeen(ctx, "evt-1", now, later); err != nil || !seen {
+			mt.Errorf("MarkSeen of a live ID = %v, %v", seen, err)
+		}
+		if _, err := s.MarkSeen(ctx, "evt-2", now, later); err == nil {
+			mt.Error("MarkSeen hid a server error")
+		}
+	},
+		mtest.CreateSuccessResponse(),
+		mtest.CreateWriteErrorsResponse(dupKey),
+		mtest.CreateWriteErrorsResponse(timeout),
+	)
+}
+
+func TestConfirmAndForget(t *testing.T) {
+	ctx := context.Background()
+	mockStore(t, func(mt *mtest.T, s *Store) {
+		if err := s.Confirm(ctx, "evt-1", later); err != nil {
+			mt.Fatal(err)
+		}
+		q, u, upsert := update(mt)
+		if q.Lookup("_id").StringValue() != "evt-1" || !timeAt(u, "$set", "expiresAt").Equal(later) || !upsert {
+			mt.Errorf("confirm %s %s, upsert %v", q, u, upsert)
+		}
+
+		if err := s.Forget(ctx, "evt-1"); err != nil {
+			mt.Fatal(err)
+		}
+		if started := mt.GetStartedEvent(); started.CommandName != "delete" {
+			mt.Errorf("Forget ran %s", started.CommandName)
+		}
+		if err := s.Confirm(ctx, "evt-2", later); !errors.As(err, &mongo.WriteException{}) {
+			mt.Errorf("Confirm error %v", err)
+		}
+	},
+		mtest.CreateSuccessResponse(),
+		mtest.CreateSuccessResponse(),
+		mtest.CreateWriteErrorsResponse(timeout),
+	)
+}
+
+func TestDeduplicatorLease(t *testing.T) {
+	events.SetClock(func() time.Time { return now })
+	defer events.SetClock(nil)
+	ctx := context.Background()
+	e := &events.AssetUpdateEventData{BaseEvent: events.BaseEvent{ID: "evt-1", Type: events.AssetUpdateEvent}}
+
+	mockStore(t, func(mt *mtest.T, s *Store) {
+		dedup := events.NewDeduplicator(s, time.Hour, nil)
+		dedup.SetLease(time.Minute)
+		if err := dedup.Process(ctx, e, func(context.Context) error { return nil }); err != nil {
+			mt.Fatal(err)
+		}
+		// Claimed for the lease, then recorded for the window
+		if _, u, _ := update(mt); !timeAt(u, "$set", "expiresAt").Equal(now.Add(time.Minute)) {
+			mt.Errorf("claim %s", u)
+		}
+		if _, u, _ := update(mt); !timeAt(u, "$set", "expiresAt").Equal(later) {
+			mt.Errorf("confirm %s", u)
+		}
+
+		failed := errors.New("insert failed")
+		if err := dedup.Process(ctx, e, func(context.Context) error { return failed }); !errors.Is(err, failed) {
+			mt.Fatalf("Process() = %v", err)
+		}
+		update(mt)
+		if started := mt.GetStartedEvent(); started.CommandName != "delete" {
+			mt.Errorf("failed handler followed by %s, want the claim deleted", started.CommandName)
+		}
+	},
+		mtest.CreateSuccessResponse(),
+		mtest.CreateSuccessResponse(),
+		mtest.CreateSuccessResponse(),
+		mtest.CreateSuccessResponse(),
+	)
+}
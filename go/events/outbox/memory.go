@@ -0,0 +1,135 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// ErrRecordNotFound is returned when a store has no record with the ID
+var ErrRecordNotFound = errors.New("outbox: record not found")
+
+// MemoryStore is a Store for tests that keeps records in insertion order
+type MemoryStore struct {
+	mu      sync.Mutex
+	records []models.OutboxRecord
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns an empty store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Insert appends a record, assigning an ID if it has none
+func (s *MemoryStore) Insert(_ context.Context, record models.OutboxRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if record.ID.IsZero() {
+		record.ID = primitive.NewObjectID()
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+// Records returns a copy of every record in insertion order
+func (s *MemoryStore) Records() []models.OutboxRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]models.OutboxRecord(nil), s.records...)
+}
+
+// Pending implements Store
+func (s *MemoryStore) Pending(_ context.Context, q PendingQuery) ([]models.OutboxRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := s.records
+	if !q.After.IsZero() {
+		for i, r := range records {
+			if r.ID == q.After {
+				records = records[i+1:]
+				break
+			}
+		}
+	}
+	var out []models.OutboxRecord
+	for _, r := range records {
+		if len(out) == q.Limit {
+			break
+		}
+		if r.Status == models.OutboxStatusPending && !slices.Contains(q.SkipKeys, r.Key) {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+// MarkPublished implements Store
+func (s *MemoryStore) MarkPublished(_ context.Context, id primitive.ObjectID, at time.Time) error {
+	return s.update(id, func(r *models.OutboxRecord) {
+		r.Status = models.OutboxStatusPublished
+		r.Attempts++
+		r.PublishedAt = &at
+	})
+}
+
+// MarkFailed implements Store
+func (s *MemoryStore) MarkFailed(_ context.Context, id primitive.ObjectID, lastError string, final bool) error {
+	return s.update(id, func(r *models.OutboxRecord) {
+		r.Attempts++
+		r.LastError = lastError
+		if final {
+			r.Status = models.OutboxStatusFailed
+		}
+	})
+}
+
+func (s *MemoryStore) update(id primitive.ObjectID, fn func(*models.OutboxRecord)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.records {
+		if s.records[i].ID == id {
+			fn(&s.records[i])
+			return nil
+		}
+	}
+	return ErrRecordNotFound
+}
+
+// MemoryPublisher is a Publisher for tests that keeps published messages
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []events.KafkaMessage
+	// Fail, if set, is called before each publish; a non-nil result fails the publish
+	Fail func(msg *events.KafkaMessage) error
+}
+
+var _ Publisher = (*MemoryPublisher)(nil)
+
+// Publish implements Publisher
+func (p *MemoryPublisher) Publish(_ context.Context, msg *events.KafkaMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.Fail != nil {
+		if err := p.Fail(msg); err != nil {
+			return err
+		}
+	}
+	p.messages = append(p.messages, *msg)
+	return nil
+}
+
+// Messages returns a copy of the published messages in publish order
+func (p *MemoryPublisher) Messages() []events.KafkaMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]events.KafkaMessage(nil), p.messages...)
+}
@@ -0,0 +1,223 @@
+// Package outbox implements the transactional outbox: services store an event as a
+// models.OutboxRecord in the same MongoDB transaction as the aggregate change, and a
+// Relay publishes stored records to Kafka afterwards. A crash between the write and the
+// publish no longer loses the event; it is published when the relay next runs.
+//
+//	_, err := session.WithTransaction(ctx, func(sc mongo.SessionContext) (any, error) {
+//		if _, err := missions.InsertOne(sc, mission); err != nil {
+//			return nil, err
+//		}
+//		record, err := outbox.NewRecord(events.NewMissionCreated(data))
+//		if err != nil {
+//			return nil, err
+//		}
+//		return outboxRecords.InsertOne(sc, record)
+//	})
+//
+// The relay publishes at least once: a record whose publish succeeded but whose status
+// update failed is published again, so consumers deduplicate by event ID
+// (see events.Deduplicator). Run one relay per outbox collection; concurrent relays can
+// publish records of one key out of order.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+const (
+	DefaultBatchSize    = 100
+	DefaultPollInterval = time.Second
+	DefaultMaxAttempts  = 10
+)
+
+// PendingQuery selects the pending records a relay reads next
+type PendingQuery struct {
+	// After, if set, skips records stored up to and including this one; a MongoDB
+	// store filters on _id greater than After and sorts by _id
+	After primitive.ObjectID
+	// SkipKeys lists keys whose records are left out, because an earlier record of the
+	// key is waiting for a retry
+	SkipKeys []string
+	// Limit is the maximum number of records returned
+	Limit int
+}
+
+// Store reads and updates stored outbox records
+type Store interface {
+	// Pending returns the pending records selected by q, oldest first
+	Pending(ctx context.Context, q PendingQuery) ([]models.OutboxRecord, error)
+	// MarkPublished sets the record's status to published
+	MarkPublished(ctx context.Context, id primitive.ObjectID, at time.Time) error
+	// MarkFailed counts a failed attempt and records its error. When final is set the
+	// status becomes failed and the relay stops retrying the record.
+	MarkFailed(ctx context.Context, id primitive.ObjectID, lastError string, final bool) error
+}
+
+// Publisher sends a message to Kafka and returns once the broker has acknowledged it
+type Publisher interface {
+	Publish(ctx context.Context, msg *events.KafkaMessage) error
+}
+
+// NewRecord encodes an event as a pending outbox record for its topic, with the
+// partition key and headers events.EncodeMessage would use. Events without a topic
+// route return events.ErrNoTopicRoute.
+func NewRecord(e events.Event) (models.OutboxRecord, error) {
+	msg, err := events.EncodeMessage(e)
+	if err != nil {
+		return models.OutboxRecord{}, err
+	}
+	if msg.Topic == "" {
+		return models.OutboxRecord{}, fmt.Errorf("%w: %q", events.ErrNoTopicRoute, e.EventBase().Type)
+	}
+	base := e.EventBase()
+	record := models.OutboxRecord{
+		ID:        primitive.NewObjectID(),
+		EventID:   base.ID,
+		EventType: string(base.Type),
+		TenantID:  base.TenantID,
+		Topic:     msg.Topic,
+		Key:       string(msg.Key),
+		Value:     msg.Value,
+		Status:    models.OutboxStatusPending,
+		CreatedAt: events.Now(),
+	}
+	if len(msg.Headers) > 0 {
+		record.Headers = make(map[string]string, len(msg.Headers))
+		for _, h := range msg.Headers {
+			record.Headers[h.Key] = string(h.Value)
+		}
+	}
+	return record, nil
+}
+
+// Message returns the Kafka message of a record, with headers sorted by key
+func Message(record *models.OutboxRecord) *events.KafkaMessage {
+	msg := &events.KafkaMessage{Topic: record.Topic, Value: record.Value}
+	if record.Key != "" {
+		msg.Key = []byte(record.Key)
+	}
+	keys := make([]string, 0, len(record.Headers))
+	for k := range record.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		msg.Headers = append(msg.Headers, events.KafkaHeader{Key: k, Value: []byte(record.Headers[k])})
+	}
+	return msg
+}
+
+// RelayOptions tunes a Relay; zero values use the defaults
+type RelayOptions struct {
+	// BatchSize is the number of records read per poll
+	BatchSize int
+	// PollInterval is the wait between polls that found no full batch
+	PollInterval time.Duration
+	// MaxAttempts is the number of publish attempts before a record is marked failed
+	MaxAttempts int
+	// OnError receives errors of Run, which keeps polling; nil ignores them
+	OnError func(error)
+}
+
+// Relay publishes pending outbox records. Records with the same key are published in
+// the order they were stored: after a failed publish, later records of that key wait
+// until it succeeds or is marked failed. Records of other keys are not held up, however
+// many records the waiting key has queued. Records without a key have no order to keep
+// and never wait for each other.
+type Relay struct {
+	store     Store
+	publisher Publisher
+	opts      RelayOptions
+}
+
+// NewRelay returns a relay from store to publisher
+func NewRelay(store Store, publisher Publisher, opts RelayOptions) *Relay {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultMaxAttempts
+	}
+	return &Relay{store: store, publisher: publisher, opts: opts}
+}
+
+// RelayOnce attempts up to BatchSize pending records and returns how many were published.
+// It reads further pages past the records of keys that are waiting for a retry.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	published, attempted := 0, 0
+	blocked := map[string]bool{}
+	q := PendingQuery{Limit: r.opts.BatchSize}
+	for attempted < r.opts.BatchSize {
+		records, err := r.store.Pending(ctx, q)
+		if err != nil {
+			return published, err
+		}
+		for i := range records {
+			record := &records[i]
+			q.After = record.ID
+			if blocked[record.Key] {
+				continue
+			}
+			attempted++
+			if err := r.publisher.Publish(ctx, Message(record)); err != nil {
+				if ctx.Err() != nil {
+					return published, ctx.Err()
+				}
+				final := record.Attempts+1 >= r.opts.MaxAttempts
+				if err := r.store.MarkFailed(ctx, record.ID, err.Error(), final); err != nil {
+					return published, err
+				}
+				if !final && record.Key != "" {
+					blocked[record.Key] = true
+					q.SkipKeys = append(q.SkipKeys, record.Key)
+				}
+			} else {
+				if err := r.store.MarkPublished(ctx, record.ID, events.Now()); err != nil {
+					return published, err
+				}
+				published++
+			}
+			if attempted == r.opts.BatchSize {
+				return published, nil
+			}
+		}
+		if len(records) < q.Limit {
+			break
+		}
+		q.Limit = r.opts.BatchSize - attempted
+	}
+	return published, nil
+}
+
+// Run relays batches until ctx is done. It polls again at once after a full batch and
+// waits PollInterval otherwise.
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		n, err := r.RelayOnce(ctx)
+		if err != nil && ctx.Err() == nil && r.opts.OnError != nil {
+			r.opts.OnError(err)
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err == nil && n == r.opts.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.opts.PollInterval):
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+func TestMain(m *testing.M) {
+	events.SetSource("outbox-test")
+	os.Exit(m.Run())
+}
+
+func missionCreated(t *testing.T, missionID string) models.OutboxRecord {
+	t.Helper()
+	record, err := NewRecord(events.NewMissionCreated(events.MissionCreatedEventData{
+		MissionID: missionID, Title: "Fire at sector 7", Priority: "high", Status: "active",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return record
+}
+
+func TestNewRecordDecodes(t *testing.T) {
+	record := missionCreated(t, "m1")
+	if record.Status != models.OutboxStatusPending || record.Topic != events.KafkaTopics.MissionEvents || record.Key != "m1" {
+		t.Fatalf("unexpected record %+v", record)
+	}
+	decoded, err := events.DecodeMessage(Message(&record))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, ok := decoded.(*events.MissionCreatedEventData)
+	if !ok || got.ID != record.EventID || got.MissionID != "m1" {
+		t.Errorf("decoded %#v", decoded)
+	}
+}
+
+func TestNewRecordUsesEventClock(t *testing.T) {
+	at := time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+	events.SetClock(func() time.Time { return at })
+	defer events.SetClock(nil)
+	if record := missionCreated(t, "m1"); !record.CreatedAt.Equal(at) {
+		t.Errorf("CreatedAt = %v, want %v", record.CreatedAt, at)
+	}
+}
+
+func TestNewRecordKeepsTenant(t *testing.T) {
+	e := events.NewMissionCreated(events.MissionCreatedEventData{MissionID: "m1", Title: "Fire", Priority: "high", Status: "active"})
+	e.TenantID = "agency-a"
+	record, err := NewRecord(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.TenantID != "agency-a" || record.Tenant() != "agency-a" {
+		t.Errorf("record tenant %q, want agency-a", record.TenantID)
+	}
+}
+
+// unrouted is an event whose type has no topic route
+type unrouted struct {
+	events.BaseEvent
+}
+
+func TestNewRecordWithoutTopic(t *testing.T) {
+	e := &unrouted{BaseEvent: events.NewBaseEvent("not_routed")}
+	if _, err := NewRecord(e); !errors.Is(err, events.ErrNoTopicRoute) {
+		t.Errorf("NewRecord() = %v, want ErrNoTopicRoute", err)
+	}
+}
+
+func TestRelayPublishesInOrder(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	for _, id := range []string{"m1", "m2", "m1"} {
+		if err := store.Insert(ctx, missionCreated(t, id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pub := &MemoryPublisher{}
+
+	n, err := NewRelay(store, pub, RelayOptions{}).RelayOnce(ctx)
+	if err != nil || n != 3 {
+		t.Fatalf("RelayOnce = %d, %v", n, err)
+	}
+	records := store.Records()
+	for i, msg := range pub.Messages() {
+		if string(msg.Key) != records[i].Key {
+			t.Errorf("message %d has key %q, want %q", i, msg.Key, records[i].Key)
+		}
+	}
+	for _, r := range records {
+		if r.Status != models.OutboxStatusPublished || r.PublishedAt == nil {
+			t.Errorf("record %s not published: %+v", r.EventID, r)
+		}
+	}
+}
+
+func TestRelayHoldsBackKeyAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	first, other, second := missionCreated(t, "m1"), missionCreated(t, "m2"), missionCreated(t, "m1")
+	for _, r := range []models.OutboxRecord{first, other, second} {
+		store.Insert(ctx, r)
+	}
+
+	broker := errors.New("broker unavailable")
+	pub := &MemoryPublisher{Fail: func(msg *events.KafkaMessage) error {
+		if string(msg.Key) == "m1" {
+			return broker
+		}
+		return nil
+	}}
+	relay := NewRelay(store, pub, RelayOptions{MaxAttempts: 2})
+
+	if n, err := relay.RelayOnce(ctx); err != nil || n != 1 {
+		t.Fatalf("first pass = %d, %v", n, err)
+	}
+	records := store.Records()
+	if records[0].Attempts != 1 || records[0].LastError != broker.Error() || records[0].Status != models.OutboxStatusPending {
+		t.Errorf("failed record: %+v", records[0])
+	}
+	if records[2].Attempts != 0 {
+		t.Errorf("later record of the failed key was attempted: %+v", records[2])
+	}
+
+	// The broker recovers: the held-back key is published in order
+	pub.Fail = nil
+	if n, err := relay.RelayOnce(ctx); err != nil || n != 2 {
+		t.Fatalf("second pass = %d, %v", n, err)
+	}
+	msgs := pub.Messages()
+	if len(msgs) != 3 || string(msgs[0].Key) != "m2" {
+		t.Fatalf("unexpected messages %v", msgs)
+	}
+	for i, want := range []string{first.EventID, second.EventID} {
+		if got, _ := msgs[i+1].Header("ce_id"); got != want {
+			t.Errorf("m1 message %d is %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestRelayPagesPastHeldBackKey(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	// The failing key has a full batch queued ahead of the other key
+	for range 4 {
+		store.Insert(ctx, missionCreated(t, "m1"))
+	}
+	other := missionCreated(t, "m2")
+	store.Insert(ctx, other)
+	pub := &MemoryPublisher{Fail: func(msg *events.KafkaMessage) error {
+		if string(msg.Key) == "m1" {
+			return errors.New("broker unavailable")
+		}
+		return nil
+	}}
+
+	if n, err := NewRelay(store, pub, RelayOptions{BatchSize: 3}).RelayOnce(ctx); err != nil || n != 1 {
+		t.Fatalf("RelayOnce = %d, %v", n, err)
+	}
+	if msgs := pub.Messages(); len(msgs) != 1 || string(msgs[0].Key) != "m2" {
+		t.Fatalf("published %v, want the m2 record", msgs)
+	}
+	records := store.Records()
+	if records[0].Attempts != 1 {
+		t.Errorf("failed m1 record attempted %d times, want 1", records[0].Attempts)
+	}
+	for _, r := range records[1:4] {
+		if r.Attempts != 0 {
+			t.Errorf("later m1 record was attempted: %+v", r)
+		}
+	}
+}
+
+func TestRelayDoesNotHoldBackKeylessRecords(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	failing, next := missionCreated(t, "m1"), missionCreated(t, "m2")
+	failing.Key, next.Key = "", ""
+	store.Insert(ctx, failing)
+	store.Insert(ctx, next)
+	pub := &MemoryPublisher{Fail: func(msg *events.KafkaMessage) error {
+		if id, _ := msg.Header("ce_id"); id == failing.EventID {
+			return errors.New("rejected")
+		}
+		return nil
+	}}
+
+	if n, err := NewRelay(store, pub, RelayOptions{}).RelayOnce(ctx); err != nil || n != 1 {
+		t.Fatalf("RelayOnce = %d, %v", n, err)
+	}
+	records := store.Records()
+	if records[0].Attempts != 1 || records[0].Status != models.OutboxStatusPending {
+		t.Errorf("failed keyless record: %+v", records[0])
+	}
+	if records[1].Status != models.OutboxStatusPublished {
+		t.Errorf("keyless record behind a failure: %s, want published", records[1].Status)
+	}
+}
+
+func TestRelayMarksFailedAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	store.Insert(ctx, missionCreated(t, "m1"))
+	store.Insert(ctx, missionCreated(t, "m1"))
+	calls := 0
+	pub := &MemoryPublisher{Fail: func(*events.KafkaMessage) error {
+		if calls++; calls == 1 {
+			return errors.New("rejected")
+		}
+		return nil
+	}}
+
+	if n, err := NewRelay(store, pub, RelayOptions{MaxAttempts: 1}).RelayOnce(ctx); err != nil || n != 1 {
+		t.Fatalf("RelayOnce = %d, %v", n, err)
+	}
+	records := store.Records()
+	if records[0].Status != models.OutboxStatusFailed {
+		t.Errorf("first record status = %s, want failed", records[0].Status)
+	}
+	if records[1].Status != models.OutboxStatusPublished {
+		t.Errorf("a final failure must not hold back the key: %s", records[1].Status)
+	}
+}
+
+func TestRunStopsWithContext(t *testing.T) {
+	store := NewMemoryStore()
+	store.Insert(context.Background(), missionCreated(t, "m1"))
+	pub := &MemoryPublisher{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := NewRelay(store, pub, RelayOptions{PollInterval: 5 * time.Millisecond}).Run(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Run returned %v", err)
+	}
+	if len(pub.Messages()) != 1 {
+		t.Errorf("published %d messages, want 1", len(pub.Messages()))
+	}
+}
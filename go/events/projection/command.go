@@ -0,0 +1,350 @@
+package projection
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+var (
+	// ErrCommandNotCreated is returned for a command event applied before its tactical_command_created
+	ErrCommandNotCreated = errors.New("projection: command not created")
+	// ErrCommandExists is returned for a second tactical_command_created of the command
+	ErrCommandExists = errors.New("projection: command already created")
+	// ErrOtherCommand is returned for an event of a different command
+	ErrOtherCommand = errors.New("projection: event belongs to another command")
+	// ErrIllegalTransition is wrapped by every Conflict
+	ErrIllegalTransition = errors.New("projection: illegal command transition")
+)
+
+// Conflict is an event a CommandProjection rejected because it does not fit the command's
+// state, e.g. a status change out of a completed command or a response from a target the
+// command was not sent to
+type Conflict struct {
+	EventID   string                       `json:"eventId"`
+	EventType events.EventType             `json:"eventType"`
+	Status    models.TacticalCommandStatus `json:"status"` // status of the command when the event arrived
+	Reason    string                       `json:"reason"`
+}
+
+func (c *Conflict) Error() string {
+	return fmt.Sprintf("%v: %s %s in status %s: %s", ErrIllegalTransition, c.EventType, c.EventID, c.Status, c.Reason)
+}
+
+func (c *Conflict) Unwrap() error {
+	return ErrIllegalTransition
+}
+
+// CommandProjection folds the tactical_command_* events of one command into a
+// models.TacticalCommand, with its responses and status history.
+//
+// Status changes follow models.IsValidStatusTransition. An event that breaks them, a
+// status change whose oldStatus is not the command's status, or a response from an
+// unknown target is not applied; Apply returns it as a *Conflict and Conflicts lists it.
+// An event ID applied twice is applied once, and an event of another tenant than
+// tactical_command_created is rejected with models.ErrCrossTenant.
+type CommandProjection struct {
+	command   models.TacticalCommand
+	created   bool
+	applied   map[string]bool
+	version   int
+	conflicts []Conflict
+}
+
+// NewCommandProjection returns an empty projection
+func NewCommandProjection() *CommandProjection {
+	return &CommandProjection{applied: map[string]bool{}}
+}
+
+// ReplayCommand folds the events of one command, in the order they happened. Conflicts
+// are recorded and skipped; any other error stops the replay.
+func ReplayCommand(stream []events.Event) (*CommandProjection, error) {
+	p := NewCommandProjection()
+	for _, e := range stream {
+		if err := p.Apply(e); err != nil && !errors.Is(err, ErrIllegalTransition) {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// ReplayCommands folds a recorded stream holding events of many commands, keyed by command
+// ID. Events of commands whose tactical_command_created is not in the stream are skipped.
+func ReplayCommands(stream []events.Event) (map[string]*CommandProjection, error) {
+	commands := map[string]*CommandProjection{}
+	for _, e := range stream {
+		id, ok := CommandIDOf(e)
+		if !ok {
+			continue
+		}
+		p, ok := commands[id]
+		if !ok {
+			if _, created := e.(*events.TacticalCommandCreatedEventData); !created {
+				continue
+			}
+			p = NewCommandProjection()
+			commands[id] = p
+		}
+		if err := p.Apply(e); err != nil && !errors.Is(err, ErrIllegalTransition) {
+			return nil, fmt.Errorf("command %s: %w", id, err)
+		}
+	}
+	return commands, nil
+}
+
+// CommandIDOf returns the command an event belongs to, for the event types a
+// CommandProjection applies
+func CommandIDOf(e events.Event) (string, bool) {
+	switch e := e.(type) {
+	case *events.TacticalCommandCreatedEventData:
+		return e.CommandID, true
+	case *events.TacticalCommandResponseEventData:
+		return e.CommandID, true
+	case *events.TacticalCommandStatusEventData:
+		return e.CommandID, true
+	}
+	return "", false
+}
+
+// Apply folds one event into the projection
+func (p *CommandProjection) Apply(e events.Event) error {
+	commandID, ok := CommandIDOf(e)
+	if !ok {
+		return nil
+	}
+	base := e.EventBase()
+	if base.ID != "" && p.applied[base.ID] {
+		return nil
+	}
+
+	created, isCreated := e.(*events.TacticalCommandCreatedEventData)
+	switch {
+	case p.created && commandID != p.command.ID.Hex():
+		return fmt.Errorf("%w: %s is for %s, not %s", ErrOtherCommand, base.Type, commandID, p.command.ID.Hex())
+	case p.created && base.TenantID != p.command.TenantID:
+		return events.CheckTenant(e, p.command)
+	case isCreated && p.created:
+		return fmt.Errorf("%w: %s", ErrCommandExists, commandID)
+	case isCreated:
+		if err := p.create(created); err != nil {
+			return err
+		}
+	case !p.created:
+		return fmt.Errorf("%w: %s before tactical_command_created", ErrCommandNotCreated, base.Type)
+	default:
+		if reason := p.apply(e); reason != "" {
+			conflict := Conflict{EventID: base.ID, EventType: base.Type, Status: p.command.Status, Reason: reason}
+			p.conflicts = append(p.conflicts, conflict)
+			if base.ID != "" {
+				p.applied[base.ID] = true
+			}
+			return &conflict
+		}
+	}
+
+	if base.ID != "" {
+		p.applied[base.ID] = true
+	}
+	p.version++
+	return nil
+}
+
+func (p *CommandProjection) create(e *events.TacticalCommandCreatedEventData) error {
+	id, err := primitive.ObjectIDFromHex(e.CommandID)
+	if err != nil {
+		return fmt.Errorf("projection: command ID %q: %w", e.CommandID, err)
+	}
+	missionID, err := primitive.ObjectIDFromHex(e.MissionID)
+	if err != nil {
+		return fmt.Errorf("projection: mission ID %q: %w", e.MissionID, err)
+	}
+	source := e.CommandSource
+	if source == "" {
+		source = "operator"
+	}
+	status := initialCommandStatus(source)
+
+	c := models.TacticalCommand{
+		ID:               id,
+		TenantID:         e.TenantID,
+		MissionID:        missionID,
+		MissionTitle:     e.MissionTitle,
+		SituationSummary: e.SituationSummary,
+		Title:            e.Title,
+		Description:      e.Description,
+		Category:         models.TacticalCommandCategory(e.Category),
+		Targets:          make([]models.CommandTarget, 0, len(e.Targets)),
+		Destination:      geoLocation(e.Destination),
+		Objective:        e.Objective,
+		Priority:         models.TacticalCommandPriority(e.Priority),
+		Status:           status,
+		StatusHistory:    []models.CommandStatusUpdate{{Status: status, Timestamp: e.Timestamp}},
+		Source:           source,
+		CreatedAt:        e.Timestamp,
+		UpdatedAt:        e.Timestamp,
+	}
+	for _, t := range e.Targets {
+		c.Targets = append(c.Targets, models.CommandTarget{TargetType: t.TargetType, TargetID: t.TargetID, TargetName: t.TargetName})
+	}
+	if area := e.AreaOfOperation; area != nil {
+		c.AreaOfOperation = &models.TacticalGeoArea{
+			Type:   area.Type,
+			Center: geoLocation(area.Center),
+			Radius: area.Radius,
+			Name:   area.Name,
+		}
+		for _, l := range area.Coordinates {
+			c.AreaOfOperation.Coordinates = append(c.AreaOfOperation.Coordinates, *geoLocation(&l))
+		}
+	}
+	p.command = c
+	p.created = true
+	return nil
+}
+
+// apply folds a response or status event, or returns why it conflicts with the command
+func (p *CommandProjection) apply(e events.Event) string {
+	c := &p.command
+	switch e := e.(type) {
+	case *events.TacticalCommandResponseEventData:
+		if models.IsFinalStatus(c.Status) {
+			return "command is " + string(c.Status)
+		}
+		if !p.hasTarget(e.TargetID) {
+			return fmt.Sprintf("%s is not a target of the command", e.TargetID)
+		}
+		if e.Decision != "accepted" && e.Decision != "rejected" {
+			return fmt.Sprintf("unknown decision %q", e.Decision)
+		}
+		status := models.TacticalCommandStatus(e.NewStatus)
+		if status != "" && status != c.Status && !models.IsValidStatusTransition(c.Status, status) {
+			return fmt.Sprintf("response moves the command to %s", status)
+		}
+
+		response := models.CommandResponse{
+			TargetID:        e.TargetID,
+			TargetType:      e.TargetType,
+			TargetName:      e.TargetName,
+			Decision:        e.Decision,
+			Notes:           e.Notes,
+			RespondedBy:     e.RespondedBy,
+			RespondedByName: e.RespondedByName,
+			RespondedAt:     e.Timestamp,
+		}
+		replaced := false
+		for i := range c.Responses {
+			if c.Responses[i].TargetID == e.TargetID {
+				c.Responses[i], replaced = response, true
+			}
+		}
+		if !replaced {
+			c.Responses = append(c.Responses, response)
+		}
+		if status != "" && status != c.Status {
+			p.setStatus(models.CommandStatusUpdate{
+				Status:        status,
+				ChangedBy:     e.RespondedBy,
+				ChangedByName: e.RespondedByName,
+				Timestamp:     e.Timestamp,
+				Notes:         e.Notes,
+			})
+		}
+		c.UpdatedAt = e.Timestamp
+
+	case *events.TacticalCommandStatusEventData:
+		status := models.TacticalCommandStatus(e.NewStatus)
+		if e.OldStatus != "" && models.TacticalCommandStatus(e.OldStatus) != c.Status {
+			return fmt.Sprintf("status change from %s, but the command is %s", e.OldStatus, c.Status)
+		}
+		if !models.IsValidStatusTransition(c.Status, status) {
+			return fmt.Sprintf("cannot move to %q", e.NewStatus)
+		}
+		p.setStatus(models.CommandStatusUpdate{
+			Status:        status,
+			ChangedBy:     e.UpdatedBy,
+			ChangedByName: e.UpdatedByName,
+			Timestamp:     e.Timestamp,
+			Notes:         e.Notes,
+		})
+		c.UpdatedAt = e.Timestamp
+	}
+	return ""
+}
+
+func (p *CommandProjection) setStatus(update models.CommandStatusUpdate) {
+	p.command.Status = update.Status
+	p.command.StatusHistory = append(p.command.StatusHistory, update)
+}
+
+func (p *CommandProjection) hasTarget(targetID string) bool {
+	for _, t := range p.command.Targets {
+		if t.TargetID == targetID {
+			return true
+		}
+	}
+	return false
+}
+
+// Created reports whether tactical_command_created has been applied
+func (p *CommandProjection) Created() bool {
+	return p.created
+}
+
+// Version returns the number of events applied, not counting conflicts
+func (p *CommandProjection) Version() int {
+	return p.version
+}
+
+// Status returns the command's current status
+func (p *CommandProjection) Status() models.TacticalCommandStatus {
+	return p.command.Status
+}
+
+// Command returns a copy of the projected command
+func (p *CommandProjection) Command() models.TacticalCommand {
+	c := p.command
+	c.Targets = append([]models.CommandTarget{}, c.Targets...)
+	c.Destination = cloneGeoLocation(c.Destination)
+	if area := c.AreaOfOperation; area != nil {
+		copied := *area
+		copied.Center = cloneGeoLocation(area.Center)
+		copied.Coordinates = append([]models.TacticalGeoLocation(nil), area.Coordinates...)
+		c.AreaOfOperation = &copied
+	}
+	c.Responses = append([]models.CommandResponse(nil), c.Responses...)
+	c.StatusHistory = append([]models.CommandStatusUpdate{}, c.StatusHistory...)
+	return c
+}
+
+// Conflicts returns the events that were rejected, in the order they arrived
+func (p *CommandProjection) Conflicts() []Conflict {
+	return append([]Conflict(nil), p.conflicts...)
+}
+
+// initialCommandStatus is the status a command is created in: AI suggestions wait for an
+// operator's approval
+func initialCommandStatus(source string) models.TacticalCommandStatus {
+	if source == "ai" {
+		return models.TacticalCommandStatusPendingApproval
+	}
+	return models.TacticalCommandStatusPending
+}
+
+func geoLocation(l *events.TacticalGeoLocation) *models.TacticalGeoLocation {
+	if l == nil {
+		return nil
+	}
+	return &models.TacticalGeoLocation{Latitude: l.Latitude, Longitude: l.Longitude, Name: l.Name, Description: l.Description}
+}
+
+func cloneGeoLocation(l *models.TacticalGeoLocation) *models.TacticalGeoLocation {
+	if l == nil {
+		return nil
+	}
+	copied := *l
+	return &copied
+}
@@ -0,0 +1,176 @@
+package projection
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+const commandID = "65f3a1b2c3d4e5f601230001"
+
+func commandCreated(id string, source string) *events.TacticalCommandCreatedEventData {
+	return &events.TacticalCommandCreatedEventData{
+		BaseEvent: base(id, events.TacticalCommandCreated, 0),
+		CommandID: commandID, MissionID: missionID, MissionTitle: "Warehouse fire",
+		Title: "Secure perimeter", Description: "Close the north gate", Category: "security", Priority: "immediate",
+		CommandSource: source,
+		Targets: []events.TacticalCommandTarget{
+			{TargetType: "asset", TargetID: "asset-2", TargetName: "Drone 2"},
+			{TargetType: "team", TargetID: "team-1", TargetName: "Alpha"},
+		},
+		Destination: &events.TacticalGeoLocation{Latitude: 47.5, Longitude: 8.7, Name: "North gate"},
+	}
+}
+
+func commandStatus(id string, minute int, from, to models.TacticalCommandStatus) *events.TacticalCommandStatusEventData {
+	return &events.TacticalCommandStatusEventData{
+		BaseEvent: base(id, events.TacticalCommandStatusChanged, minute), CommandID: commandID, MissionID: missionID,
+		OldStatus: string(from), NewStatus: string(to), UpdatedBy: "op-1", UpdatedByName: "Operator",
+	}
+}
+
+func commandResponse(id string, minute int, target, decision string, to models.TacticalCommandStatus) *events.TacticalCommandResponseEventData {
+	return &events.TacticalCommandResponseEventData{
+		BaseEvent: base(id, events.TacticalCommandResponse, minute), CommandID: commandID, MissionID: missionID,
+		TargetID: target, TargetType: "asset", TargetName: target, Decision: decision,
+		RespondedBy: "user-" + target, RespondedByName: "Pilot", NewStatus: string(to),
+	}
+}
+
+func TestReplayCommand(t *testing.T) {
+	p, err := ReplayCommand([]events.Event{
+		commandCreated("c1", "ai"),
+		commandStatus("c2", 1, models.TacticalCommandStatusPendingApproval, models.TacticalCommandStatusPending),
+		commandResponse("c3", 2, "asset-2", "accepted", models.TacticalCommandStatusAccepted),
+		commandResponse("c4", 3, "team-1", "accepted", models.TacticalCommandStatusAccepted),
+		commandStatus("c5", 4, models.TacticalCommandStatusAccepted, models.TacticalCommandStatusInProgress),
+		commandStatus("c6", 9, models.TacticalCommandStatusInProgress, models.TacticalCommandStatusCompleted),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := p.Command()
+	if c.Status != models.TacticalCommandStatusCompleted || c.Source != "ai" || c.ID.Hex() != commandID || c.MissionID.Hex() != missionID {
+		t.Errorf("command %+v", c)
+	}
+	if c.Destination == nil || c.Destination.Name != "North gate" || len(c.Targets) != 2 || len(c.Responses) != 2 {
+		t.Errorf("command %+v", c)
+	}
+
+	var history []models.TacticalCommandStatus
+	for _, u := range c.StatusHistory {
+		history = append(history, u.Status)
+	}
+	want := []models.TacticalCommandStatus{
+		models.TacticalCommandStatusPendingApproval,
+		models.TacticalCommandStatusPending,
+		models.TacticalCommandStatusAccepted,
+		models.TacticalCommandStatusInProgress,
+		models.TacticalCommandStatusCompleted,
+	}
+	if !reflect.DeepEqual(history, want) {
+		t.Errorf("status history %v, want %v", history, want)
+	}
+	if accepted := c.StatusHistory[2]; accepted.ChangedBy != "user-asset-2" || !accepted.Timestamp.Equal(t0.Add(2*time.Minute)) {
+		t.Errorf("accepted by %+v", accepted)
+	}
+	if !c.UpdatedAt.Equal(t0.Add(9*time.Minute)) || p.Version() != 6 || len(p.Conflicts()) != 0 {
+		t.Errorf("updated %v, version %d, conflicts %v", c.UpdatedAt, p.Version(), p.Conflicts())
+	}
+}
+
+func TestCommandConflicts(t *testing.T) {
+	p, err := ReplayCommand([]events.Event{
+		commandCreated("c1", "operator"),
+		commandResponse("c2", 1, "asset-9", "accepted", models.TacticalCommandStatusAccepted),
+		commandStatus("c3", 2, models.TacticalCommandStatusPending, models.TacticalCommandStatusCompleted),
+		commandStatus("c4", 3, models.TacticalCommandStatusAccepted, models.TacticalCommandStatusInProgress),
+		commandStatus("c5", 4, models.TacticalCommandStatusPending, models.TacticalCommandStatusCancelled),
+		commandResponse("c6", 5, "asset-2", "accepted", models.TacticalCommandStatusAccepted),
+		commandStatus("c5", 4, models.TacticalCommandStatusPending, models.TacticalCommandStatusCancelled),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Status() != models.TacticalCommandStatusCancelled || p.Version() != 2 {
+		t.Errorf("status %s, version %d", p.Status(), p.Version())
+	}
+
+	var rejected []string
+	for _, c := range p.Conflicts() {
+		rejected = append(rejected, c.EventID)
+	}
+	if want := []string{"c2", "c3", "c4", "c6"}; !reflect.DeepEqual(rejected, want) {
+		t.Errorf("conflicts %v, want %v", p.Conflicts(), want)
+	}
+	if c := p.Conflicts()[3]; c.Status != models.TacticalCommandStatusCancelled {
+		t.Errorf("late response conflict %+v", c)
+	}
+	if c := p.Command(); len(c.Responses) != 0 || len(c.StatusHistory) != 2 {
+		t.Errorf("conflicting events changed the command: %+v", c)
+	}
+
+	err = p.Apply(commandStatus("c7", 6, models.TacticalCommandStatusCancelled, models.TacticalCommandStatusPending))
+	var conflict *Conflict
+	if !errors.As(err, &conflict) || !errors.Is(err, ErrIllegalTransition) || conflict.EventID != "c7" {
+		t.Errorf("Apply() = %v", err)
+	}
+}
+
+func TestCommandApplyErrors(t *testing.T) {
+	p := NewCommandProjection()
+	if err := p.Apply(commandStatus("c1", 1, "", models.TacticalCommandStatusAccepted)); !errors.Is(err, ErrCommandNotCreated) {
+		t.Errorf("event before creation: %v", err)
+	}
+	if err := p.Apply(commandCreated("c2", "")); err != nil {
+		t.Fatal(err)
+	}
+	if p.Status() != models.TacticalCommandStatusPending || p.Command().Source != "operator" {
+		t.Errorf("operator command created as %+v", p.Command())
+	}
+	if err := p.Apply(commandCreated("c3", "")); !errors.Is(err, ErrCommandExists) {
+		t.Errorf("second tactical_command_created: %v", err)
+	}
+	other := commandStatus("c4", 1, "", models.TacticalCommandStatusAccepted)
+	other.CommandID = "65f3a1b2c3d4e5f601230002"
+	if err := p.Apply(other); !errors.Is(err, ErrOtherCommand) {
+		t.Errorf("event of another command: %v", err)
+	}
+}
+
+func TestReplayCommands(t *testing.T) {
+	second := commandCreated("d1", "operator")
+	second.CommandID = "65f3a1b2c3d4e5f601230002"
+	commands, err := ReplayCommands([]events.Event{
+		commandCreated("c1", "operator"),
+		second,
+		commandResponse("c2", 1, "asset-2", "rejected", models.TacticalCommandStatusRejected),
+		missionStream()[0],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commands) != 2 || commands[commandID].Status() != models.TacticalCommandStatusRejected ||
+		commands[second.CommandID].Status() != models.TacticalCommandStatusPending {
+		t.Errorf("commands = %v", commands)
+	}
+}
+
+func TestStatusTransitions(t *testing.T) {
+	for _, s := range models.ValidStatuses() {
+		final := s == models.TacticalCommandStatusCompleted || s == models.TacticalCommandStatusCancelled
+		if models.IsFinalStatus(s) != final {
+			t.Errorf("IsFinalStatus(%s) = %v", s, !final)
+		}
+		if models.IsValidStatusTransition(s, s) {
+			t.Errorf("%s may move to itself", s)
+		}
+	}
+	if models.IsFinalStatus("archived") {
+		t.Error("unknown status is final")
+	}
+}
@@ -0,0 +1,328 @@
+// Package projection rebuilds models from their event streams. Projections are pure
+// folds: the same events in the same order always produce the same model, with every
+// timestamp taken from the events, so they can rebuild read models, audit what happened
+// to an aggregate and check services against recorded streams.
+package projection
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+var (
+	// ErrMissionNotCreated is returned for a mission event applied before its mission_created
+	ErrMissionNotCreated = errors.New("projection: mission not created")
+	// ErrMissionExists is returned for a second mission_created of the mission
+	ErrMissionExists = errors.New("projection: mission already created")
+	// ErrOtherMission is returned for an event of a different mission
+	ErrOtherMission = errors.New("projection: event belongs to another mission")
+)
+
+// MissionProjection folds the events of one mission into models.Mission and
+// models.EnrichedMission. Each tactical command of the mission is one dispatch, with the
+// targets' responses as dispatch responses.
+//
+// Events that change the mission:
+//   - mission_created starts it
+//   - suggestion_created adds the correlated event to EventIDs
+//   - tactical_command_created adds a dispatch and its asset targets
+//   - tactical_command_response records a response and the command status
+//   - tactical_command_status_changed updates the command status
+//
+// ai_mission_suggestion and mission chat events do not change the mission; the
+// projection keeps the latest suggested commands and counts chat messages. Other event
+// types are ignored, and an event ID applied twice is applied once. An event of another
+// tenant than mission_created is rejected with models.ErrCrossTenant.
+type MissionProjection struct {
+	mission      models.EnrichedMission
+	created      bool
+	applied      map[string]bool
+	version      int
+	suggestions  []events.TacticalCommandSuggestion
+	chatMessages int
+}
+
+// NewMissionProjection returns an empty projection
+func NewMissionProjection() *MissionProjection {
+	return &MissionProjection{applied: map[string]bool{}}
+}
+
+// ReplayMission folds the events of one mission, in the order they happened
+func ReplayMission(stream []events.Event) (*MissionProjection, error) {
+	p := NewMissionProjection()
+	for _, e := range stream {
+		if err := p.Apply(e); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// ReplayMissions folds a recorded stream holding events of many missions, keyed by mission
+// ID. Events of missions whose mission_created is not in the stream are skipped.
+func ReplayMissions(stream []events.Event) (map[string]*MissionProjection, error) {
+	missions := map[string]*MissionProjection{}
+	for _, e := range stream {
+		id, ok := MissionIDOf(e)
+		if !ok {
+			continue
+		}
+		p, ok := missions[id]
+		if !ok {
+			if _, created := e.(*events.MissionCreatedEventData); !created {
+				continue
+			}
+			p = NewMissionProjection()
+			missions[id] = p
+		}
+		if err := p.Apply(e); err != nil {
+			return nil, fmt.Errorf("mission %s: %w", id, err)
+		}
+	}
+	return missions, nil
+}
+
+// MissionIDOf returns the mission an event belongs to, for the event types a
+// MissionProjection applies
+func MissionIDOf(e events.Event) (string, bool) {
+	switch e := e.(type) {
+	case *events.MissionCreatedEventData:
+		return e.MissionID, true
+	case *events.SuggestionCreatedEventData:
+		return e.MissionID, true
+	case *events.AIMissionSuggestionEventData:
+		return e.MissionID, true
+	case *events.TacticalCommandCreatedEventData:
+		return e.MissionID, true
+	case *events.TacticalCommandResponseEventData:
+		return e.MissionID, true
+	case *events.TacticalCommandStatusEventData:
+		return e.MissionID, true
+	case *events.MissionChatMessageEventData:
+		return e.MissionID, true
+	case *events.MissionTypingIndicatorEventData:
+		return e.MissionID, true
+	}
+	return "", false
+}
+
+// Apply folds one event into the projection
+func (p *MissionProjection) Apply(e events.Event) error {
+	missionID, ok := MissionIDOf(e)
+	if !ok {
+		return nil
+	}
+	base := e.EventBase()
+	if base.ID != "" && p.applied[base.ID] {
+		return nil
+	}
+
+	created, isCreated := e.(*events.MissionCreatedEventData)
+	switch {
+	case p.created && missionID != p.mission.ID.Hex():
+		return fmt.Errorf("%w: %s is for %s, not %s", ErrOtherMission, base.Type, missionID, p.mission.ID.Hex())
+	case p.created && base.TenantID != p.mission.TenantID:
+		return events.CheckTenant(e, p.mission.Mission)
+	case isCreated && p.created:
+		return fmt.Errorf("%w: %s", ErrMissionExists, missionID)
+	case isCreated:
+		if err := p.create(created); err != nil {
+			return err
+		}
+	case !p.created:
+		return fmt.Errorf("%w: %s before mission_created", ErrMissionNotCreated, base.Type)
+	default:
+		p.apply(e)
+	}
+
+	if base.ID != "" {
+		p.applied[base.ID] = true
+	}
+	p.version++
+	return nil
+}
+
+func (p *MissionProjection) create(e *events.MissionCreatedEventData) error {
+	id, err := primitive.ObjectIDFromHex(e.MissionID)
+	if err != nil {
+		return fmt.Errorf("projection: mission ID %q: %w", e.MissionID, err)
+	}
+	status := models.MissionStatus(e.Status)
+	if status == "" {
+		status = models.MissionStatusActive
+	}
+	m := models.Mission{
+		ID:          id,
+		TenantID:    e.TenantID,
+		Title:       e.Title,
+		Description: e.Description,
+		Status:      status,
+		Priority:    e.Priority,
+		DispatchIDs: []string{},
+		AssetIDs:    appendUnique(nil, e.AssetIDs...),
+		EventIDs:    []string{},
+		Tags:        appendUnique(nil, e.Tags...),
+		CreatedAt:   e.Timestamp,
+		UpdatedAt:   e.Timestamp,
+	}
+	if e.Location != nil {
+		m.Location = &models.GeoLocation{Type: "Point", Coordinates: []float64{e.Location.Longitude, e.Location.Latitude}}
+	}
+	p.mission = models.EnrichedMission{Mission: m, Dispatches: []models.EnrichedDispatch{}}
+	p.created = true
+	return nil
+}
+
+func (p *MissionProjection) apply(e events.Event) {
+	m := &p.mission
+	switch e := e.(type) {
+	case *events.SuggestionCreatedEventData:
+		m.EventIDs = appendUnique(m.EventIDs, e.EventID)
+		m.UpdatedAt = e.Timestamp
+
+	case *events.TacticalCommandCreatedEventData:
+		if p.dispatch(e.CommandID) != nil {
+			return
+		}
+		status := initialCommandStatus(e.CommandSource)
+		m.Dispatches = append(m.Dispatches, models.EnrichedDispatch{
+			ID:          e.CommandID,
+			EventID:     e.CausationID,
+			Description: e.Title,
+			Status:      string(status),
+			Priority:    e.Priority,
+			Responses:   []models.DispatchResponseSummary{},
+			CreatedAt:   e.Timestamp,
+		})
+		m.DispatchIDs = appendUnique(m.DispatchIDs, e.CommandID)
+		for _, t := range e.Targets {
+			if t.TargetType == "asset" {
+				m.AssetIDs = appendUnique(m.AssetIDs, t.TargetID)
+			}
+		}
+		m.UpdatedAt = e.Timestamp
+
+	case *events.TacticalCommandResponseEventData:
+		d := p.dispatch(e.CommandID)
+		if d == nil {
+			return
+		}
+		response := models.DispatchResponseSummary{
+			AssetID:      e.TargetID,
+			AssetName:    e.TargetName,
+			Accepted:     e.Decision == "accepted",
+			ResponseTime: e.Timestamp,
+			Notes:        e.Notes,
+		}
+		replaced := false
+		for i := range d.Responses {
+			if d.Responses[i].AssetID == e.TargetID {
+				d.Responses[i], replaced = response, true
+			}
+		}
+		if !replaced {
+			d.Responses = append(d.Responses, response)
+		}
+		if e.NewStatus != "" {
+			d.Status = e.NewStatus
+		}
+		m.UpdatedAt = e.Timestamp
+
+	case *events.TacticalCommandStatusEventData:
+		if d := p.dispatch(e.CommandID); d != nil {
+			d.Status = e.NewStatus
+			m.UpdatedAt = e.Timestamp
+		}
+
+	case *events.AIMissionSuggestionEventData:
+		p.suggestions = append([]events.TacticalCommandSuggestion(nil), e.TacticalCommands...)
+
+	case *events.MissionChatMessageEventData:
+		p.chatMessages++
+	}
+}
+
+// dispatch returns the dispatch of a command, or nil for a command created outside the stream
+func (p *MissionProjection) dispatch(commandID string) *models.EnrichedDispatch {
+	for i := range p.mission.Dispatches {
+		if p.mission.Dispatches[i].ID == commandID {
+			return &p.mission.Dispatches[i]
+		}
+	}
+	return nil
+}
+
+// Created reports whether mission_created has been applied
+func (p *MissionProjection) Created() bool {
+	return p.created
+}
+
+// Version returns the number of events applied
+func (p *MissionProjection) Version() int {
+	return p.version
+}
+
+// Mission returns a copy of the projected mission
+func (p *MissionProjection) Mission() models.Mission {
+	return p.Enriched().Mission
+}
+
+// Enriched returns a copy of the projected mission with its dispatches
+func (p *MissionProjection) Enriched() models.EnrichedMission {
+	m := p.mission
+	m.DispatchIDs = cloneStrings(m.DispatchIDs)
+	m.AssetIDs = cloneStrings(m.AssetIDs)
+	m.EventIDs = cloneStrings(m.EventIDs)
+	m.Tags = cloneStrings(m.Tags)
+	if m.Location != nil {
+		loc := *m.Location
+		loc.Coordinates = append([]float64(nil), loc.Coordinates...)
+		m.Location = &loc
+	}
+	m.Dispatches = make([]models.EnrichedDispatch, len(p.mission.Dispatches))
+	for i, d := range p.mission.Dispatches {
+		d.Responses = append([]models.DispatchResponseSummary{}, d.Responses...)
+		m.Dispatches[i] = d
+	}
+	return m
+}
+
+// SuggestedCommands returns the commands of the latest ai_mission_suggestion
+func (p *MissionProjection) SuggestedCommands() []events.TacticalCommandSuggestion {
+	return append([]events.TacticalCommandSuggestion(nil), p.suggestions...)
+}
+
+// ChatMessages returns the number of mission chat messages
+func (p *MissionProjection) ChatMessages() int {
+	return p.chatMessages
+}
+
+// cloneStrings copies a list; empty lists stay non-nil, as they are stored and served
+func cloneStrings(list []string) []string {
+	return append([]string{}, list...)
+}
+
+// appendUnique appends the non-empty values not already in list, keeping first-seen order
+func appendUnique(list []string, values ...string) []string {
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		found := false
+		for _, x := range list {
+			if x == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, v)
+		}
+	}
+	return cloneStrings(list)
+}
@@ -0,0 +1,209 @@
+package projection
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+const missionID = "65f3a1b2c3d4e5f601234567"
+
+var t0 = time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+
+func base(id string, eventType events.EventType, minute int) events.BaseEvent {
+	return events.BaseEvent{ID: id, Type: eventType, Timestamp: t0.Add(time.Duration(minute) * time.Minute), Source: "test"}
+}
+
+// missionStream is a recorded incident: creation, a correlated event, an AI suggestion,
+// a command to two assets with both responses, a status change and chat
+func missionStream() []events.Event {
+	command := &events.TacticalCommandCreatedEventData{
+		BaseEvent: base("e4", events.TacticalCommandCreated, 4),
+		CommandID: "cmd-1", MissionID: missionID, Title: "Secure perimeter", Priority: "immediate",
+		CommandSource: "operator",
+		Targets: []events.TacticalCommandTarget{
+			{TargetType: "asset", TargetID: "asset-2", TargetName: "Drone 2"},
+			{TargetType: "asset", TargetID: "asset-3", TargetName: "Unit 3"},
+			{TargetType: "team", TargetID: "team-1", TargetName: "Alpha"},
+		},
+	}
+	command.CausationID = "e3"
+	return []events.Event{
+		&events.MissionCreatedEventData{
+			BaseEvent: base("e1", events.MissionCreated, 0),
+			MissionID: missionID, Title: "Warehouse fire", Description: "Smoke reported", Priority: "high",
+			Status: "active", AssetIDs: []string{"asset-1"}, Tags: []string{"fire"},
+			Location: &events.LocationData{Latitude: 47.5, Longitude: 8.7},
+		},
+		&events.SuggestionCreatedEventData{
+			BaseEvent: base("e2", events.SuggestionCreated, 1), SuggestionID: "s1", EventID: "cam-event-9", MissionID: missionID,
+		},
+		&events.AIMissionSuggestionEventData{
+			BaseEvent: base("e3", events.AIMissionSuggestion, 2), MissionID: missionID,
+			TacticalCommands: []events.TacticalCommandSuggestion{{Title: "Secure perimeter", Priority: "immediate"}},
+		},
+		command,
+		&events.TacticalCommandResponseEventData{
+			BaseEvent: base("e5", events.TacticalCommandResponse, 5), CommandID: "cmd-1", MissionID: missionID,
+			TargetID: "asset-2", TargetName: "Drone 2", Decision: "accepted", NewStatus: "accepted",
+		},
+		&events.MissionChatMessageEventData{
+			BaseEvent: base("e6", events.MissionChatMessageEvent, 6), MissionID: missionID, Content: "On my way",
+		},
+		&events.TacticalCommandResponseEventData{
+			BaseEvent: base("e7", events.TacticalCommandResponse, 7), CommandID: "cmd-1", MissionID: missionID,
+			TargetID: "asset-3", TargetName: "Unit 3", Decision: "rejected", Notes: "Low fuel", NewStatus: "accepted",
+		},
+		&events.TacticalCommandStatusEventData{
+			BaseEvent: base("e8", events.TacticalCommandStatusChanged, 8), CommandID: "cmd-1", MissionID: missionID,
+			OldStatus: "accepted", NewStatus: "in_progress",
+		},
+	}
+}
+
+func TestReplayMission(t *testing.T) {
+	p, err := ReplayMission(missionStream())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, _ := primitive.ObjectIDFromHex(missionID)
+	want := models.EnrichedMission{
+		Mission: models.Mission{
+			ID: id, Title: "Warehouse fire", Description: "Smoke reported", Status: models.MissionStatusActive, Priority: "high",
+			DispatchIDs: []string{"cmd-1"},
+			AssetIDs:    []string{"asset-1", "asset-2", "asset-3"},
+			EventIDs:    []string{"cam-event-9"},
+			Location:    &models.GeoLocation{Type: "Point", Coordinates: []float64{8.7, 47.5}},
+			Tags:        []string{"fire"},
+			CreatedAt:   t0,
+			UpdatedAt:   t0.Add(8 * time.Minute),
+		},
+		Dispatches: []models.EnrichedDispatch{{
+			ID: "cmd-1", EventID: "e3", Description: "Secure perimeter", Status: "in_progress", Priority: "immediate",
+			Responses: []models.DispatchResponseSummary{
+				{AssetID: "asset-2", AssetName: "Drone 2", Accepted: true, ResponseTime: t0.Add(5 * time.Minute)},
+				{AssetID: "asset-3", AssetName: "Unit 3", Accepted: false, ResponseTime: t0.Add(7 * time.Minute), Notes: "Low fuel"},
+			},
+			CreatedAt: t0.Add(4 * time.Minute),
+		}},
+	}
+	if got := p.Enriched(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Enriched()\n got: %+v\nwant: %+v", got, want)
+	}
+	if got := p.Mission(); !reflect.DeepEqual(got, want.Mission) {
+		t.Errorf("Mission() = %+v", got)
+	}
+	if p.Version() != 8 || p.ChatMessages() != 1 || len(p.SuggestedCommands()) != 1 {
+		t.Errorf("version %d, chat %d, suggestions %v", p.Version(), p.ChatMessages(), p.SuggestedCommands())
+	}
+}
+
+func TestReplayMissionIsDeterministic(t *testing.T) {
+	encode := func() []byte {
+		p, err := ReplayMission(missionStream())
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := json.Marshal(p.Enriched())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	if a, b := encode(), encode(); string(a) != string(b) {
+		t.Errorf("replays differ:\n%s\n%s", a, b)
+	}
+}
+
+func TestApplyIgnoresRedelivery(t *testing.T) {
+	stream := missionStream()
+	p, err := ReplayMission(append(stream, stream[1], stream[4]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Version() != len(stream) || len(p.Mission().EventIDs) != 1 || len(p.Enriched().Dispatches[0].Responses) != 2 {
+		t.Errorf("redelivered events changed the projection: %+v", p.Enriched())
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	stream := missionStream()
+	if _, err := ReplayMission(stream[1:]); !errors.Is(err, ErrMissionNotCreated) {
+		t.Errorf("event before creation: %v", err)
+	}
+
+	p, _ := ReplayMission(stream[:1])
+	other := &events.MissionChatMessageEventData{BaseEvent: base("x1", events.MissionChatMessageEvent, 9), MissionID: "65f3a1b2c3d4e5f601234568"}
+	if err := p.Apply(other); !errors.Is(err, ErrOtherMission) {
+		t.Errorf("event of another mission: %v", err)
+	}
+	again := *stream[0].(*events.MissionCreatedEventData)
+	again.ID = "e1-again"
+	if err := p.Apply(&again); !errors.Is(err, ErrMissionExists) {
+		t.Errorf("second mission_created: %v", err)
+	}
+	if err := p.Apply(&events.VitalsUpdateEventData{BaseEvent: base("v1", events.VitalsUpdateEvent, 9)}); err != nil {
+		t.Errorf("unrelated event: %v", err)
+	}
+}
+
+func TestReplayMissions(t *testing.T) {
+	stream := missionStream()
+	orphan := &events.MissionChatMessageEventData{BaseEvent: base("o1", events.MissionChatMessageEvent, 1), MissionID: "65f3a1b2c3d4e5f601234569"}
+	missions, err := ReplayMissions(append([]events.Event{orphan}, stream...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(missions) != 1 || missions[missionID].Version() != len(stream) {
+		t.Errorf("missions = %v", missions)
+	}
+}
+
+func TestApplyRejectsOtherTenant(t *testing.T) {
+	stream := missionStream()
+	stream[0].EventBase().TenantID = "agency-a"
+	p, err := ReplayMission(stream[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Mission().TenantID != "agency-a" {
+		t.Errorf("mission tenant %q", p.Mission().TenantID)
+	}
+	if err := p.Apply(stream[1]); !errors.Is(err, models.ErrCrossTenant) {
+		t.Errorf("event without the mission's tenant: %v", err)
+	}
+}
+
+func TestDerivedEventsKeepTenant(t *testing.T) {
+	events.SetTopicNaming(events.TenantTopics)
+	t.Cleanup(func() { events.SetTopicNaming(events.SharedTopics) })
+
+	stream := missionStream()
+	root := stream[0].EventBase()
+	root.TenantID = "agency-a"
+	for _, e := range stream[1:] {
+		e.EventBase().DeriveFrom(root)
+		topic, err := events.TopicForEvent(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tenant, _ := events.SplitTenantTopic(topic); tenant != "agency-a" {
+			t.Errorf("%s routed to %s, want a topic of agency-a", e.EventBase().Type, topic)
+		}
+	}
+	p, err := ReplayMission(stream)
+	if err != nil {
+		t.Fatalf("derived events rejected: %v", err)
+	}
+	if p.Version() != len(stream) {
+		t.Errorf("version %d, want %d", p.Version(), len(stream))
+	}
+}
@@ -0,0 +1,304 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	// Registers the phlx.events.v1 messages
+	_ "github.com/ai-project-787/phlx-contracts/go/events/eventspb"
+)
+
+// Protobuf mirror of the payload structs, defined in proto/phlx/events/v1/events.proto.
+//
+// Each struct maps to the message of the same name in protoPackage. Fields are matched
+// by name: the snake_case form of the JSON key, and for embedded structs the snake_case
+// form of the type name (BaseEvent → base_event). A struct field without a counterpart
+// in its message is an error, so the .proto file cannot silently fall behind.
+//
+// Protobuf cannot tell nil from empty, so empty slices decode as nil; timestamps decode
+// in UTC and metadata numbers decode as float64, the same as they do from JSON.
+
+// protoPackage is the Protobuf package that mirrors this one
+const protoPackage protoreflect.FullName = "phlx.events.v1"
+
+// ErrNoProtoMessage is returned for structs that have no Protobuf message
+var ErrNoProtoMessage = errors.New("events: no Protobuf message")
+
+// ToProto converts a payload into its generated Protobuf message
+func ToProto(payload Event) (proto.Message, error) {
+	v := reflect.ValueOf(payload)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return nil, fmt.Errorf("events: ToProto requires a non-nil payload pointer, got %T", payload)
+	}
+	mt, err := protoMessageType(v.Elem().Type())
+	if err != nil {
+		return nil, err
+	}
+	m := mt.New()
+	if err := structToProto(v.Elem(), m); err != nil {
+		return nil, err
+	}
+	return m.Interface(), nil
+}
+
+// FromProto converts a generated Protobuf message into its registered payload struct,
+// chosen by the event type in base_event
+func FromProto(msg proto.Message) (Event, error) {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("base_event")
+	if fd == nil || fd.Message() == nil {
+		return nil, fmt.Errorf("%w: %s is not an event payload", ErrNoProtoMessage, m.Descriptor().FullName())
+	}
+	eventType := EventType(m.Get(fd).Message().Get(fd.Message().Fields().ByName("type")).String())
+
+	payload, err := NewPayload(eventType)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(payload).Elem()
+	if want := protoPackage.Append(protoreflect.Name(v.Type().Name())); m.Descriptor().FullName() != want {
+		return nil, fmt.Errorf("%w: %q payload is %s, got %s", ErrPayloadMismatch, eventType, want, m.Descriptor().FullName())
+	}
+	if err := protoToStruct(m, v); err != nil {
+		return nil, err
+	}
+	return payload.(Event), nil
+}
+
+// protoMessageType finds the message mirroring a struct
+func protoMessageType(t reflect.Type) (protoreflect.MessageType, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoPackage.Append(protoreflect.Name(t.Name())))
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s", ErrNoProtoMessage, t)
+	}
+	return mt, nil
+}
+
+// protoField pairs a struct field with the message field it maps to
+type protoField struct {
+	index int
+	fd    protoreflect.FieldDescriptor
+}
+
+// protoFields caches the field mapping of each struct
+var protoFields sync.Map // reflect.Type → []protoField
+
+// fieldsFor returns the field mapping between a struct and its message
+func fieldsFor(t reflect.Type, md protoreflect.MessageDescriptor) ([]protoField, error) {
+	if cached, ok := protoFields.Load(t); ok {
+		return cached.([]protoField), nil
+	}
+
+	var fields []protoField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		if !f.Anonymous {
+			tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		fd := md.Fields().ByName(protoreflect.Name(snakeCase(name)))
+		if fd == nil {
+			return nil, fmt.Errorf("%w: %s has no field %q for %s.%s", ErrNoProtoMessage, md.FullName(), snakeCase(name), t, f.Name)
+		}
+		fields = append(fields, protoField{index: i, fd: fd})
+	}
+	protoFields.Store(t, fields)
+	return fields, nil
+}
+
+// snakeCase converts a JSON key or type name to a Protobuf field name
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			nextLower := i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || nextLower {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func structToProto(v reflect.Value, m protoreflect.Message) error {
+	fields, err := fieldsFor(v.Type(), m.Descriptor())
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		fv := v.Field(f.index)
+		if f.fd.IsList() {
+			list := m.Mutable(f.fd).List()
+			for i := 0; i < fv.Len(); i++ {
+				elem, ok, err := valueToProto(f.fd, fv.Index(i), list.NewElement)
+				if err != nil {
+					return err
+				}
+				if ok {
+					list.Append(elem)
+				}
+			}
+			continue
+		}
+		pv, ok, err := valueToProto(f.fd, fv, func() protoreflect.Value { return m.NewField(f.fd) })
+		if err != nil {
+			return err
+		}
+		if ok {
+			m.Set(f.fd, pv)
+		}
+	}
+	return nil
+}
+
+// valueToProto converts one value; ok is false when the message field should stay unset
+func valueToProto(fd protoreflect.FieldDescriptor, v reflect.Value, newMessage func() protoreflect.Value) (protoreflect.Value, bool, error) {
+	if fd.Message() == nil {
+		switch v.Kind() {
+		case reflect.String:
+			return protoreflect.ValueOfString(v.String()), true, nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return protoreflect.ValueOfInt64(v.Int()), true, nil
+		case reflect.Float32, reflect.Float64:
+			return protoreflect.ValueOfFloat64(v.Float()), true, nil
+		case reflect.Bool:
+			return protoreflect.ValueOfBool(v.Bool()), true, nil
+		case reflect.Slice:
+			if v.Type().Elem().Kind() == reflect.Uint8 {
+				return protoreflect.ValueOfBytes(v.Bytes()), true, nil
+			}
+		}
+		return protoreflect.Value{}, false, fmt.Errorf("events: cannot convert %s to Protobuf %s", v.Type(), fd.FullName())
+	}
+
+	if v.Kind() == reflect.Pointer || v.Kind() == reflect.Map {
+		if v.IsNil() {
+			return protoreflect.Value{}, false, nil
+		}
+	}
+	switch fd.Message().FullName() {
+	case "google.protobuf.Timestamp":
+		t, ok := reflect.Indirect(v).Interface().(time.Time)
+		if !ok {
+			return protoreflect.Value{}, false, fmt.Errorf("events: cannot convert %s to Protobuf %s", v.Type(), fd.FullName())
+		}
+		if t.IsZero() {
+			return protoreflect.Value{}, false, nil
+		}
+		return protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()), true, nil
+	case "google.protobuf.Struct":
+		fields, ok := v.Interface().(map[string]interface{})
+		if !ok {
+			return protoreflect.Value{}, false, fmt.Errorf("events: cannot convert %s to Protobuf %s", v.Type(), fd.FullName())
+		}
+		s, err := structpb.NewStruct(fields)
+		if err != nil {
+			return protoreflect.Value{}, false, fmt.Errorf("events: %s: %w", fd.FullName(), err)
+		}
+		return protoreflect.ValueOfMessage(s.ProtoReflect()), true, nil
+	}
+
+	pv := newMessage()
+	if err := structToProto(reflect.Indirect(v), pv.Message()); err != nil {
+		return protoreflect.Value{}, false, err
+	}
+	return pv, true, nil
+}
+
+func protoToStruct(m protoreflect.Message, v reflect.Value) error {
+	fields, err := fieldsFor(v.Type(), m.Descriptor())
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		fv := v.Field(f.index)
+		if f.fd.IsList() {
+			list := m.Get(f.fd).List()
+			if list.Len() == 0 {
+				continue
+			}
+			slice := reflect.MakeSlice(fv.Type(), list.Len(), list.Len())
+			for i := 0; i < list.Len(); i++ {
+				if err := valueFromProto(f.fd, list.Get(i), slice.Index(i)); err != nil {
+					return err
+				}
+			}
+			fv.Set(slice)
+			continue
+		}
+		if f.fd.Message() != nil && !m.Has(f.fd) {
+			continue
+		}
+		if err := valueFromProto(f.fd, m.Get(f.fd), fv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// valueFromProto stores one message value into a struct field
+func valueFromProto(fd protoreflect.FieldDescriptor, pv protoreflect.Value, v reflect.Value) error {
+	if fd.Message() == nil {
+		switch v.Kind() {
+		case reflect.String:
+			v.SetString(pv.String())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if v.OverflowInt(pv.Int()) {
+				return fmt.Errorf("events: %s value %d overflows %s", fd.FullName(), pv.Int(), v.Type())
+			}
+			v.SetInt(pv.Int())
+		case reflect.Float32, reflect.Float64:
+			v.SetFloat(pv.Float())
+		case reflect.Bool:
+			v.SetBool(pv.Bool())
+		case reflect.Slice:
+			if v.Type().Elem().Kind() != reflect.Uint8 {
+				return fmt.Errorf("events: cannot convert Protobuf %s to %s", fd.FullName(), v.Type())
+			}
+			if len(pv.Bytes()) > 0 {
+				v.SetBytes(append([]byte(nil), pv.Bytes()...))
+			}
+		default:
+			return fmt.Errorf("events: cannot convert Protobuf %s to %s", fd.FullName(), v.Type())
+		}
+		return nil
+	}
+
+	if v.Kind() == reflect.Pointer {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	switch msg := pv.Message().Interface().(type) {
+	case *timestamppb.Timestamp:
+		v.Set(reflect.ValueOf(msg.AsTime()))
+		return nil
+	case *structpb.Struct:
+		v.Set(reflect.ValueOf(msg.AsMap()))
+		return nil
+	}
+	return protoToStruct(pv.Message(), v)
+}
@@ -0,0 +1,228 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownEventType is returned when an event type has no registered payload
+var ErrUnknownEventType = errors.New("events: unknown event type")
+
+// ErrPayloadMismatch is returned when an event decodes to a different payload than requested
+var ErrPayloadMismatch = errors.New("events: payload type mismatch")
+
+// Event is implemented by every payload that embeds BaseEvent
+type Event interface {
+	EventBase() *BaseEvent
+}
+
+// EventBase returns the embedded event metadata so payloads satisfy Event
+func (e *BaseEvent) EventBase() *BaseEvent {
+	return e
+}
+
+// Envelope pairs the common event metadata with a typed payload.
+// On the wire an envelope is the flat payload JSON, exactly as producers send it today.
+type Envelope[T any] struct {
+	BaseEvent
+	Payload *T
+}
+
+// MarshalJSON writes the payload with the envelope metadata applied
+func (e Envelope[T]) MarshalJSON() ([]byte, error) {
+	if e.Payload == nil {
+		return json.Marshal(e.BaseEvent)
+	}
+	payload := *e.Payload
+	if ev, ok := any(&payload).(Event); ok {
+		*ev.EventBase() = e.BaseEvent
+		return json.Marshal(payload)
+	}
+
+	// Payloads that do not embed BaseEvent are merged with the metadata fields
+	fields := map[string]json.RawMessage{}
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("events: payload %T must encode as a JSON object: %w", payload, err)
+	}
+	base, err := json.Marshal(e.BaseEvent)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(base, &fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON fills both the metadata and the payload from the flat event JSON
+func (e *Envelope[T]) UnmarshalJSON(data []byte) error {
+	payload := new(T)
+	if err := json.Unmarshal(data, payload); err != nil {
+		return err
+	}
+
+	// Payloads that embed BaseEvent already hold the metadata; some of them
+	// shadow "timestamp" with their own field, so it must not be decoded twice
+	if ev, ok := any(payload).(Event); ok {
+		e.BaseEvent = *ev.EventBase()
+	} else if err := json.Unmarshal(data, &e.BaseEvent); err != nil {
+		return err
+	}
+	e.Payload = payload
+	return nil
+}
+
+// payloadRegistry maps each event type to the struct its payload decodes into
+var payloadRegistry = struct {
+	sync.RWMutex
+	types map[EventType]reflect.Type
+}{types: map[EventType]reflect.Type{}}
+
+// Register associates an event type with its payload struct.
+// It returns an error if the event type is already registered.
+func Register[T any](eventType EventType) error {
+	payloadRegistry.Lock()
+	defer payloadRegistry.Unlock()
+
+	if existing, ok := payloadRegistry.types[eventType]; ok {
+		return fmt.Errorf("events: %q already registered to %s", eventType, existing)
+	}
+	payloadRegistry.types[eventType] = reflect.TypeOf((*T)(nil)).Elem()
+	return nil
+}
+
+// MustRegister is like Register but panics on duplicate registration.
+// Services use it from init() to add private event types.
+func MustRegister[T any](eventType EventType) {
+	if err := Register[T](eventType); err != nil {
+		panic(err)
+	}
+}
+
+// IsRegistered reports whether a payload is registered for the event type
+func IsRegistered(eventType EventType) bool {
+	payloadRegistry.RLock()
+	defer payloadRegistry.RUnlock()
+	_, ok := payloadRegistry.types[eventType]
+	return ok
+}
+
+// RegisteredTypes returns all registered event types in sorted order
+func RegisteredTypes() []EventType {
+	payloadRegistry.RLock()
+	defer payloadRegistry.RUnlock()
+
+	types := make([]EventType, 0, len(payloadRegistry.types))
+	for t := range payloadRegistry.types {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// NewPayload returns a pointer to a new zero payload for the event type
+func NewPayload(eventType EventType) (any, error) {
+	payloadRegistry.RLock()
+	t, ok := payloadRegistry.types[eventType]
+	payloadRegistry.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEventType, eventType)
+	}
+	return reflect.New(t).Interface(), nil
+}
+
+// PeekType reads the event type from raw event JSON without decoding the payload
+func PeekType(data []byte) (EventType, error) {
+	var head struct {
+		Type EventType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return "", fmt.Errorf("events: decode event type: %w", err)
+	}
+	if head.Type == "" {
+		return "", errors.New("events: event has no type")
+	}
+	return head.Type, nil
+}
+
+// Decode reads raw event JSON and returns a pointer to the registered payload struct,
+// e.g. *MissionCreatedEventData for a "mission_created" event.
+// Payloads from older schema versions are upcast first.
+func Decode(data []byte) (any, error) {
+	eventType, err := PeekType(data)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := NewPayload(eventType)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = Upcast(data); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return nil, fmt.Errorf("events: decode %q payload: %w", eventType, err)
+	}
+	return payload, nil
+}
+
+// DecodeEnvelope decodes raw event JSON into an Envelope of the expected payload type.
+// It fails with ErrPayloadMismatch if the event type is registered to a different struct.
+func DecodeEnvelope[T any](data []byte) (*Envelope[T], error) {
+	eventType, err := PeekType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadRegistry.RLock()
+	t, ok := payloadRegistry.types[eventType]
+	payloadRegistry.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEventType, eventType)
+	}
+	if want := reflect.TypeOf((*T)(nil)).Elem(); t != want {
+		return nil, fmt.Errorf("%w: %q decodes to %s, not %s", ErrPayloadMismatch, eventType, t, want)
+	}
+
+	if data, err = Upcast(data); err != nil {
+		return nil, err
+	}
+
+	env := &Envelope[T]{}
+	if err := json.Unmarshal(data, env); err != nil {
+		return nil, fmt.Errorf("events: decode %q payload: %w", eventType, err)
+	}
+	return env, nil
+}
+
+func init() {
+	MustRegister[AssetUpdateEventData](AssetUpdateEvent)
+	MustRegister[AssetRecallEventData](AssetRecallEvent)
+	MustRegister[EmergencyNotificationEventData](EmergencyNotification)
+	MustRegister[ChatMessageEventData](ChatMessageEvent)
+	MustRegister[SystemStatusEventData](SystemStatusEvent)
+	MustRegister[LocationUpdateEventData](LocationUpdateEvent)
+	MustRegister[VitalsUpdateEventData](VitalsUpdateEvent)
+	MustRegister[VideoUploadEventData](VideoUploadEvent)
+	MustRegister[VideoProcessingEventData](VideoProcessingEvent)
+	MustRegister[FrameExtractionEventData](FrameExtractionEvent)
+	MustRegister[FrameUploadCompleteEventData](FrameUploadCompleteEvent)
+	MustRegister[AIAnalysisEventData](AIAnalysisEvent)
+	MustRegister[EventAnalysisEventData](EventAnalysisEvent)
+	MustRegister[SuggestionCreatedEventData](SuggestionCreated)
+	MustRegister[MissionCreatedEventData](MissionCreated)
+	MustRegister[AIMissionSuggestionEventData](AIMissionSuggestion)
+	MustRegister[TacticalCommandCreatedEventData](TacticalCommandCreated)
+	MustRegister[TacticalCommandResponseEventData](TacticalCommandResponse)
+	MustRegister[TacticalCommandStatusEventData](TacticalCommandStatusChanged)
+	MustRegister[MissionChatMessageEventData](MissionChatMessageEvent)
+	MustRegister[MissionTypingIndicatorEventData](MissionTypingIndicatorEvent)
+}
@@ -0,0 +1,233 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// withoutGoPayload lists event types whose payload is only defined in TypeScript
+var withoutGoPayload = []EventType{TacticalSuggestionCreated}
+
+// declaredEventTypes parses the package sources for EventType constants, so the
+// checks do not depend on a hand-kept list of types
+func declaredEventTypes(t *testing.T) []EventType {
+	t.Helper()
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var declared []EventType
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if typ, ok := vs.Type.(*ast.Ident); !ok || typ.Name != "EventType" {
+					continue
+				}
+				for _, value := range vs.Values {
+					lit, ok := value.(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						t.Fatalf("%s: EventType constant is not a string literal", fset.Position(value.Pos()))
+					}
+					s, err := strconv.Unquote(lit.Value)
+					if err != nil {
+						t.Fatal(err)
+					}
+					declared = append(declared, EventType(s))
+				}
+			}
+		}
+	}
+	if len(declared) == 0 {
+		t.Fatal("no EventType constants found")
+	}
+	return declared
+}
+
+func TestEveryEventTypeIsRegistered(t *testing.T) {
+	declared := declaredEventTypes(t)
+	for _, eventType := range declared {
+		payload, err := NewPayload(eventType)
+		if slices.Contains(withoutGoPayload, eventType) {
+			if err == nil {
+				t.Errorf("%s has a payload now; remove it from withoutGoPayload", eventType)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", eventType, err)
+			continue
+		}
+		if _, ok := payload.(Event); !ok {
+			t.Errorf("%s: payload %T does not embed BaseEvent", eventType, payload)
+		}
+	}
+	types := RegisteredTypes()
+	for _, eventType := range types {
+		if !slices.Contains(declared, eventType) {
+			t.Errorf("%s is registered but not declared as an EventType constant", eventType)
+		}
+	}
+	if !sort.SliceIsSorted(types, func(i, j int) bool { return types[i] < types[j] }) {
+		t.Errorf("RegisteredTypes() is not sorted: %v", types)
+	}
+}
+
+// privatePayload is a service-private event registered by a test
+type privatePayload struct {
+	BaseEvent
+	Note string `json:"note"`
+}
+
+// registerPrivate registers privatePayload under eventType until the test ends
+func registerPrivate(t *testing.T, eventType EventType) {
+	if err := Register[privatePayload](eventType); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		payloadRegistry.Lock()
+		defer payloadRegistry.Unlock()
+		delete(payloadRegistry.types, eventType)
+	})
+}
+
+func TestRegister(t *testing.T) {
+	registerPrivate(t, "registry_test.private")
+	if !IsRegistered("registry_test.private") {
+		t.Error("private type is not registered")
+	}
+	if err := Register[privatePayload]("registry_test.private"); err == nil {
+		t.Error("second registration succeeded")
+	}
+	if err := Register[privatePayload](MissionCreated); err == nil {
+		t.Error("registration over a contract type succeeded")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustRegister of a duplicate did not panic")
+		}
+	}()
+	MustRegister[privatePayload](MissionCreated)
+}
+
+func TestDecode(t *testing.T) {
+	registerPrivate(t, "registry_test.decode")
+	for _, tt := range []struct {
+		name    string
+		data    string
+		want    any
+		wantErr error
+	}{
+		{"contract type", `{"id":"e1","type":"mission_created","missionId":"m1"}`, &MissionCreatedEventData{}, nil},
+		{"private type", `{"id":"e2","type":"registry_test.decode","note":"hi"}`, &privatePayload{}, nil},
+		{"unknown type", `{"id":"e3","type":"does_not_exist"}`, nil, ErrUnknownEventType},
+		{"missing type", `{"id":"e4"}`, nil, nil},
+		{"not JSON", `mission_created`, nil, nil},
+		{"wrong field type", `{"id":"e5","type":"mission_created","missionId":7}`, nil, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode([]byte(tt.data))
+			if tt.want == nil {
+				if err == nil {
+					t.Fatalf("Decode() = %T, want an error", got)
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("Decode() error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reflect.TypeOf(got) != reflect.TypeOf(tt.want) {
+				t.Errorf("Decode() = %T, want %T", got, tt.want)
+			}
+			var head BaseEvent
+			json.Unmarshal([]byte(tt.data), &head)
+			if got.(Event).EventBase().ID != head.ID {
+				t.Errorf("decoded ID %q, want %q", got.(Event).EventBase().ID, head.ID)
+			}
+		})
+	}
+}
+
+func TestDecodeEnvelope(t *testing.T) {
+	data := []byte(`{"id":"e1","type":"mission_created","source":"test","missionId":"m1"}`)
+	env, err := DecodeEnvelope[MissionCreatedEventData](data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.ID != "e1" || env.Source != "test" || env.Payload.MissionID != "m1" {
+		t.Errorf("envelope %+v, payload %+v", env.BaseEvent, env.Payload)
+	}
+	if _, err := DecodeEnvelope[VitalsUpdateEventData](data); !errors.Is(err, ErrPayloadMismatch) {
+		t.Errorf("wrong payload type: %v", err)
+	}
+	if _, err := DecodeEnvelope[MissionCreatedEventData]([]byte(`{"type":"does_not_exist"}`)); !errors.Is(err, ErrUnknownEventType) {
+		t.Errorf("unknown type: %v", err)
+	}
+}
+
+// plainPayload does not embed BaseEvent, so envelopes merge the metadata into it
+type plainPayload struct {
+	Note string `json:"note"`
+}
+
+func TestEnvelopeJSON(t *testing.T) {
+	base := BaseEvent{ID: "e1", Type: "registry_test.plain", Source: "test"}
+	for name, tt := range map[string]struct {
+		env  any
+		want string
+	}{
+		"embedded": {
+			Envelope[MissionCreatedEventData]{BaseEvent: base, Payload: &MissionCreatedEventData{MissionID: "m1"}},
+			`"id":"e1"`,
+		},
+		"merged":     {Envelope[plainPayload]{BaseEvent: base, Payload: &plainPayload{Note: "hi"}}, `"note":"hi"`},
+		"no payload": {Envelope[plainPayload]{BaseEvent: base}, `"source":"test"`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(tt.env)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var fields map[string]any
+			if err := json.Unmarshal(data, &fields); err != nil {
+				t.Fatal(err)
+			}
+			if fields["id"] != "e1" || fields["type"] != "registry_test.plain" || !strings.Contains(string(data), tt.want) {
+				t.Errorf("envelope JSON %s, want metadata and %s", data, tt.want)
+			}
+		})
+	}
+
+	var back Envelope[plainPayload]
+	if err := json.Unmarshal([]byte(`{"id":"e1","type":"x","note":"hi"}`), &back); err != nil {
+		t.Fatal(err)
+	}
+	if back.ID != "e1" || back.Payload.Note != "hi" {
+		t.Errorf("decoded envelope %+v, payload %+v", back.BaseEvent, back.Payload)
+	}
+}
@@ -0,0 +1,159 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNoTopicRoute is returned when an event type is not routed to any Kafka topic
+var ErrNoTopicRoute = errors.New("events: no topic route for event type")
+
+// PartitionKeyer is implemented by payloads that name the aggregate they belong to.
+// Producers use the key as the Kafka message key so events for one aggregate stay ordered.
+type PartitionKeyer interface {
+	PartitionKey() string
+}
+
+// topicRoutes is the authoritative EventType → Kafka topic mapping
+var topicRoutes = struct {
+	sync.RWMutex
+	topics map[EventType]string
+}{topics: map[EventType]string{
+	AssetUpdateEvent:             KafkaTopics.AssetUpdates,
+	AssetRecallEvent:             KafkaTopics.AssetUpdates,
+	EmergencyNotification:        KafkaTopics.EmergencyNotifications,
+	ChatMessageEvent:             KafkaTopics.ChatMessages,
+	SystemStatusEvent:            KafkaTopics.SystemStatus,
+	LocationUpdateEvent:          KafkaTopics.LocationUpdates,
+	VitalsUpdateEvent:            KafkaTopics.VitalsUpdates,
+	VideoUploadEvent:             KafkaTopics.VideoUploads,
+	VideoProcessingEvent:         KafkaTopics.VideoProcessing,
+	FrameExtractionEvent:         KafkaTopics.FrameExtraction,
+	FrameUploadCompleteEvent:     KafkaTopics.FrameUploadComplete,
+	AIAnalysisEvent:              KafkaTopics.AIAnalysis,
+	EventAnalysisEvent:           KafkaTopics.EventAnalysis,
+	SuggestionCreated:            KafkaTopics.Suggestions,
+	MissionCreated:               KafkaTopics.MissionEvents,
+	AIMissionSuggestion:          KafkaTopics.AIMissionSuggestions,
+	TacticalCommandCreated:       KafkaTopics.TacticalCommands,
+	TacticalCommandResponse:      KafkaTopics.TacticalCommands,
+	TacticalCommandStatusChanged: KafkaTopics.TacticalCommands,
+	TacticalSuggestionCreated:    KafkaTopics.AIMissionSuggestions,
+	FireAlertCreatedEvent:        KafkaTopics.EmergencyNotifications,
+	FireRiskDetectedEvent:        KafkaTopics.FireEvents,
+	FireRiskUpdatedEvent:         KafkaTopics.FireEvents,
+	FireRiskClearedEvent:         KafkaTopics.FireEvents,
+	MissionChatMessageEvent:      KafkaTopics.MissionChat,
+	MissionTypingIndicatorEvent:  KafkaTopics.MissionChat,
+}}
+
+// TopicFor returns the Kafka topic an event type is published to
+func TopicFor(eventType EventType) (string, error) {
+	topicRoutes.RLock()
+	defer topicRoutes.RUnlock()
+
+	topic, ok := topicRoutes.topics[eventType]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrNoTopicRoute, eventType)
+	}
+	return topic, nil
+}
+
+// RegisterTopic routes a service-private event type to a topic.
+// It returns an error if the event type is already routed.
+func RegisterTopic(eventType EventType, topic string) error {
+	topicRoutes.Lock()
+	defer topicRoutes.Unlock()
+
+	if existing, ok := topicRoutes.topics[eventType]; ok {
+		return fmt.Errorf("events: %q already routed to %q", eventType, existing)
+	}
+	topicRoutes.topics[eventType] = topic
+	return nil
+}
+
+// PartitionKeyFor returns the partition key of a payload, falling back to the event ID
+// for payloads that do not belong to an aggregate
+func PartitionKeyFor(payload any) string {
+	if p, ok := payload.(PartitionKeyer); ok {
+		if key := p.PartitionKey(); key != "" {
+			return key
+		}
+	}
+	if e, ok := payload.(Event); ok {
+		return e.EventBase().ID
+	}
+	return ""
+}
+
+// systemStatusPartitionKey keeps all system status changes on one partition
+const systemStatusPartitionKey = "system"
+
+// PartitionKey returns the asset ID
+func (e *AssetUpdateEventData) PartitionKey() string { return e.AssetID }
+
+// PartitionKey returns the asset ID so a recall is ordered with the asset's status updates
+func (e *AssetRecallEventData) PartitionKey() string { return e.AssetID }
+
+// PartitionKey returns the notification ID
+func (e *EmergencyNotificationEventData) PartitionKey() string { return e.NotificationID }
+
+// PartitionKey returns the chat session ID, or the message ID for sessionless messages
+func (e *ChatMessageEventData) PartitionKey() string {
+	if e.SessionID != "" {
+		return e.SessionID
+	}
+	return e.MessageID
+}
+
+// PartitionKey returns a constant key; system status is a single aggregate
+func (e *SystemStatusEventData) PartitionKey() string { return systemStatusPartitionKey }
+
+// PartitionKey returns the asset ID
+func (e *LocationUpdateEventData) PartitionKey() string { return e.AssetID }
+
+// PartitionKey returns the personnel ID
+func (e *VitalsUpdateEventData) PartitionKey() string { return e.PersonnelID }
+
+// PartitionKey returns the video ID
+func (e *VideoUploadEventData) PartitionKey() string { return e.VideoID }
+
+// PartitionKey returns the video ID
+func (e *VideoProcessingEventData) PartitionKey() string { return e.VideoID }
+
+// PartitionKey returns the video ID
+func (e *FrameExtractionEventData) PartitionKey() string { return e.VideoID }
+
+// PartitionKey returns the video ID
+func (e *FrameUploadCompleteEventData) PartitionKey() string { return e.VideoID }
+
+// PartitionKey returns the video ID
+func (e *AIAnalysisEventData) PartitionKey() string { return e.VideoID }
+
+// PartitionKey returns the video ID
+func (e *EventAnalysisEventData) PartitionKey() string { return e.VideoID }
+
+// PartitionKey returns the mission ID
+func (e *SuggestionCreatedEventData) PartitionKey() string { return e.MissionID }
+
+// PartitionKey returns the mission ID
+func (e *MissionCreatedEventData) PartitionKey() string { return e.MissionID }
+
+// PartitionKey returns the mission ID
+func (e *AIMissionSuggestionEventData) PartitionKey() string { return e.MissionID }
+
+// PartitionKey returns the command ID
+func (e *TacticalCommandCreatedEventData) PartitionKey() string { return e.CommandID }
+
+// PartitionKey returns the command ID
+func (e *TacticalCommandResponseEventData) PartitionKey() string { return e.CommandID }
+
+// PartitionKey returns the command ID
+func (e *TacticalCommandStatusEventData) PartitionKey() string { return e.CommandID }
+
+// PartitionKey returns the mission ID
+func (e *MissionChatMessageEventData) PartitionKey() string { return e.MissionID }
+
+// PartitionKey returns the mission ID
+func (e *MissionTypingIndicatorEventData) PartitionKey() string { return e.MissionID }
@@ -0,0 +1,66 @@
+package events
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestValidEventTypesMatchDeclared(t *testing.T) {
+	declared := declaredEventTypes(t)
+	for _, eventType := range declared {
+		if !IsValidEventType(eventType) {
+			t.Errorf("%s is declared but not in ValidEventTypes", eventType)
+		}
+	}
+	for _, eventType := range ValidEventTypes() {
+		if !slices.Contains(declared, eventType) {
+			t.Errorf("%s is in ValidEventTypes but not declared as an EventType constant", eventType)
+		}
+	}
+}
+
+// TestEveryEventTypeHasTopicRoute walks the declared constants rather than the
+// registry, so a type without a payload or a ValidEventTypes entry still needs a route
+func TestEveryEventTypeHasTopicRoute(t *testing.T) {
+	for _, eventType := range declaredEventTypes(t) {
+		topic, err := TopicFor(eventType)
+		if err != nil {
+			t.Errorf("%s: %v", eventType, err)
+			continue
+		}
+		if topic == "" {
+			t.Errorf("%s: routed to empty topic", eventType)
+		}
+	}
+}
+
+func TestTopicForUnknownType(t *testing.T) {
+	if _, err := TopicFor("does_not_exist"); !errors.Is(err, ErrNoTopicRoute) {
+		t.Fatalf("expected ErrNoTopicRoute, got %v", err)
+	}
+}
+
+func TestRegisteredPayloadsHavePartitionKey(t *testing.T) {
+	for _, eventType := range RegisteredTypes() {
+		payload, err := NewPayload(eventType)
+		if err != nil {
+			t.Fatalf("%s: %v", eventType, err)
+		}
+		if _, ok := payload.(PartitionKeyer); !ok {
+			t.Errorf("%s: %T does not implement PartitionKeyer", eventType, payload)
+		}
+	}
+}
+
+func TestPartitionKeyFor(t *testing.T) {
+	location := &LocationUpdateEventData{BaseEvent: BaseEvent{ID: "evt-1"}, AssetID: "asset-1"}
+	if got := PartitionKeyFor(location); got != "asset-1" {
+		t.Errorf("location update key = %q, want asset-1", got)
+	}
+
+	chat := &ChatMessageEventData{BaseEvent: BaseEvent{ID: "evt-2"}}
+	if got := PartitionKeyFor(chat); got != "evt-2" {
+		t.Errorf("empty chat key should fall back to event ID, got %q", got)
+	}
+}
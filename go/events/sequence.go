@@ -0,0 +1,241 @@
+package events
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Per-aggregate sequence numbers.
+//
+// Producers number the events of one aggregate, e.g. the tactical_command_* events of a
+// command, with BaseEvent.AggregateID and a Sequence starting at 1 that grows by one per
+// event. models.TacticalCommand has no version field, so the owning service keeps the
+// counter on the aggregate's document and bumps it in the update that changes the
+// aggregate, reading the new value back with FindOneAndUpdate (options.After):
+//
+//	update := bson.M{"$set": changes, "$inc": bson.M{"event_seq": 1}}
+//	// ... decode event_seq of the returned document into seq
+//	e := events.NewTacticalCommandStatusChanged(data)
+//	e.AggregateID, e.Sequence = cmd.ID.Hex(), seq
+//
+// Consumers feed events to a SequenceTracker, which classifies each one and lists the
+// ranges that were skipped, so they can be re-fetched from the owning service.
+
+// SequenceStatus classifies an event against the sequence seen so far for its aggregate
+type SequenceStatus int
+
+const (
+	// SequenceUntracked means the event has no aggregate ID or sequence
+	SequenceUntracked SequenceStatus = iota
+	// SequenceInOrder means the event is the next one expected
+	SequenceInOrder
+	// SequenceDuplicate means the sequence was already seen
+	SequenceDuplicate
+	// SequenceGap means earlier sequences are missing; see SequenceTracker.Gaps
+	SequenceGap
+	// SequenceLate means the event fills a gap: it arrived after a later event
+	SequenceLate
+)
+
+func (s SequenceStatus) String() string {
+	switch s {
+	case SequenceUntracked:
+		return "untracked"
+	case SequenceInOrder:
+		return "in_order"
+	case SequenceDuplicate:
+		return "duplicate"
+	case SequenceGap:
+		return "gap"
+	case SequenceLate:
+		return "late"
+	}
+	return fmt.Sprintf("SequenceStatus(%d)", int(s))
+}
+
+// SequenceRange is an inclusive range of missing sequences of an aggregate
+type SequenceRange struct {
+	AggregateID string `json:"aggregateId"`
+	From        int64  `json:"from"`
+	To          int64  `json:"to"`
+}
+
+func (r SequenceRange) String() string {
+	if r.From == r.To {
+		return fmt.Sprintf("%s#%d", r.AggregateID, r.From)
+	}
+	return fmt.Sprintf("%s#%d-%d", r.AggregateID, r.From, r.To)
+}
+
+// aggregateSequence is what a tracker knows about one aggregate
+type aggregateSequence struct {
+	start  int64          // lowest sequence known
+	next   int64          // first sequence not yet seen contiguously
+	ahead  map[int64]bool // sequences seen beyond next
+	seeded bool           // sequences below start were processed, rather than not seen yet
+}
+
+// SequenceTracker detects gaps, duplicates and reordering per aggregate. The first
+// sequence seen for an aggregate is taken as its start, since a consumer may join after
+// the beginning of the stream. A lower sequence seen later is late and moves the start
+// back, so the sequences between them become a gap. Seed sets the start from stored
+// state instead.
+type SequenceTracker struct {
+	mu         sync.Mutex
+	aggregates map[string]*aggregateSequence
+}
+
+// NewSequenceTracker returns a tracker that knows no aggregates
+func NewSequenceTracker() *SequenceTracker {
+	return &SequenceTracker{aggregates: map[string]*aggregateSequence{}}
+}
+
+// Seed records that every sequence of the aggregate up to last was already processed,
+// e.g. after loading a projection or re-fetching a gap. Later sequences seen before are kept.
+func (t *SequenceTracker) Seed(aggregateID string, last int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	a, ok := t.aggregates[aggregateID]
+	if !ok {
+		a = &aggregateSequence{start: last + 1, next: last + 1, ahead: map[int64]bool{}}
+		t.aggregates[aggregateID] = a
+	}
+	if !a.seeded && last+1 < a.start {
+		a.rewind(last + 1)
+	}
+	a.seeded = true
+	if last+1 > a.next {
+		a.next = last + 1
+		for seq := range a.ahead {
+			if seq < a.next {
+				delete(a.ahead, seq)
+			}
+		}
+		a.advance()
+	}
+}
+
+// Track classifies an event by its AggregateID and Sequence and records it
+func (t *SequenceTracker) Track(e Event) SequenceStatus {
+	base := e.EventBase()
+	return t.Observe(base.AggregateID, base.Sequence)
+}
+
+// Observe classifies a sequence of an aggregate and records it
+func (t *SequenceTracker) Observe(aggregateID string, seq int64) SequenceStatus {
+	if aggregateID == "" || seq <= 0 {
+		return SequenceUntracked
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.aggregates[aggregateID]
+	if !ok {
+		t.aggregates[aggregateID] = &aggregateSequence{start: seq, next: seq + 1, ahead: map[int64]bool{}}
+		return SequenceInOrder
+	}
+	switch {
+	case seq < a.start && !a.seeded:
+		a.rewind(seq)
+		a.next++
+		a.advance()
+		return SequenceLate
+	case seq < a.next || a.ahead[seq]:
+		return SequenceDuplicate
+	case seq == a.next:
+		late := len(a.ahead) > 0
+		a.next++
+		a.advance()
+		if late {
+			return SequenceLate
+		}
+		return SequenceInOrder
+	default:
+		late := seq < a.maxAhead()
+		a.ahead[seq] = true
+		if late {
+			return SequenceLate
+		}
+		return SequenceGap
+	}
+}
+
+// advance moves next past sequences already seen ahead of it
+func (a *aggregateSequence) advance() {
+	for a.ahead[a.next] {
+		delete(a.ahead, a.next)
+		a.next++
+	}
+}
+
+// rewind moves the start back to seq, keeping the sequences seen contiguously so far
+// as seen ahead of it
+func (a *aggregateSequence) rewind(seq int64) {
+	for s := a.start; s < a.next; s++ {
+		a.ahead[s] = true
+	}
+	a.start, a.next = seq, seq
+}
+
+func (a *aggregateSequence) maxAhead() int64 {
+	var max int64
+	for seq := range a.ahead {
+		if seq > max {
+			max = seq
+		}
+	}
+	return max
+}
+
+// Gaps returns the missing ranges of an aggregate, in order
+func (t *SequenceTracker) Gaps(aggregateID string) []SequenceRange {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	a, ok := t.aggregates[aggregateID]
+	if !ok || len(a.ahead) == 0 {
+		return nil
+	}
+
+	seen := make([]int64, 0, len(a.ahead))
+	for seq := range a.ahead {
+		seen = append(seen, seq)
+	}
+	sort.Slice(seen, func(i, j int) bool { return seen[i] < seen[j] })
+
+	var gaps []SequenceRange
+	from := a.next
+	for _, seq := range seen {
+		if seq > from {
+			gaps = append(gaps, SequenceRange{AggregateID: aggregateID, From: from, To: seq - 1})
+		}
+		from = seq + 1
+	}
+	return gaps
+}
+
+// AllGaps returns the missing ranges of every aggregate, ordered by aggregate ID
+func (t *SequenceTracker) AllGaps() []SequenceRange {
+	t.mu.Lock()
+	ids := make([]string, 0, len(t.aggregates))
+	for id, a := range t.aggregates {
+		if len(a.ahead) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	t.mu.Unlock()
+	sort.Strings(ids)
+
+	var gaps []SequenceRange
+	for _, id := range ids {
+		gaps = append(gaps, t.Gaps(id)...)
+	}
+	return gaps
+}
+
+// Forget drops what the tracker knows about an aggregate, e.g. once it is closed
+func (t *SequenceTracker) Forget(aggregateID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.aggregates, aggregateID)
+}
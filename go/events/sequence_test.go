@@ -0,0 +1,139 @@
+package events
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSequenceTrackerClassifies(t *testing.T) {
+	tr := NewSequenceTracker()
+	steps := []struct {
+		seq  int64
+		want SequenceStatus
+	}{
+		{1, SequenceInOrder},
+		{2, SequenceInOrder},
+		{2, SequenceDuplicate},
+		{5, SequenceGap},
+		{4, SequenceLate},
+		{7, SequenceGap},
+		{3, SequenceLate},
+		{5, SequenceDuplicate},
+		{6, SequenceLate},
+		{8, SequenceInOrder},
+		{1, SequenceDuplicate},
+	}
+	for i, s := range steps {
+		if got := tr.Observe("cmd-1", s.seq); got != s.want {
+			t.Errorf("step %d: Observe(%d) = %s, want %s", i, s.seq, got, s.want)
+		}
+	}
+	if gaps := tr.Gaps("cmd-1"); gaps != nil {
+		t.Errorf("unexpected gaps %v", gaps)
+	}
+}
+
+func TestSequenceTrackerGaps(t *testing.T) {
+	tr := NewSequenceTracker()
+	for _, seq := range []int64{10, 11, 14, 15, 18} {
+		tr.Observe("cmd-1", seq)
+	}
+	tr.Observe("cmd-0", 1)
+	tr.Observe("cmd-0", 3)
+
+	want := []SequenceRange{
+		{AggregateID: "cmd-0", From: 2, To: 2},
+		{AggregateID: "cmd-1", From: 12, To: 13},
+		{AggregateID: "cmd-1", From: 16, To: 17},
+	}
+	if got := tr.AllGaps(); !reflect.DeepEqual(got, want) {
+		t.Errorf("AllGaps() = %v, want %v", got, want)
+	}
+	if got := want[1].String(); got != "cmd-1#12-13" {
+		t.Errorf("String() = %q", got)
+	}
+
+	// Re-fetching 12-15 closes the first gap
+	tr.Seed("cmd-1", 15)
+	if got := tr.Gaps("cmd-1"); !reflect.DeepEqual(got, want[2:]) {
+		t.Errorf("after Seed, Gaps() = %v", got)
+	}
+	tr.Observe("cmd-1", 16)
+	tr.Observe("cmd-1", 17)
+	if got := tr.Observe("cmd-1", 19); got != SequenceInOrder {
+		t.Errorf("after filling every gap, next sequence is %s", got)
+	}
+}
+
+func TestSequenceTrackerSeedStart(t *testing.T) {
+	tr := NewSequenceTracker()
+	tr.Seed("cmd-1", 4)
+	if got := tr.Observe("cmd-1", 4); got != SequenceDuplicate {
+		t.Errorf("seeded sequence is %s, want duplicate", got)
+	}
+	if got := tr.Observe("cmd-1", 6); got != SequenceGap {
+		t.Errorf("got %s, want gap", got)
+	}
+	if got := tr.Gaps("cmd-1"); len(got) != 1 || got[0].From != 5 || got[0].To != 5 {
+		t.Errorf("Gaps() = %v", got)
+	}
+}
+
+func TestSequenceTrackerReorderedStart(t *testing.T) {
+	tr := NewSequenceTracker()
+	steps := []struct {
+		seq  int64
+		want SequenceStatus
+	}{
+		{5, SequenceInOrder},
+		{3, SequenceLate},
+		{3, SequenceDuplicate},
+		{5, SequenceDuplicate},
+	}
+	for i, s := range steps {
+		if got := tr.Observe("cmd-1", s.seq); got != s.want {
+			t.Errorf("step %d: Observe(%d) = %s, want %s", i, s.seq, got, s.want)
+		}
+	}
+	want := []SequenceRange{{AggregateID: "cmd-1", From: 4, To: 4}}
+	if got := tr.Gaps("cmd-1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Gaps() = %v, want %v", got, want)
+	}
+	if got := tr.Observe("cmd-1", 4); got != SequenceLate {
+		t.Errorf("filling the gap is %s, want late", got)
+	}
+	if got := tr.Observe("cmd-1", 6); got != SequenceInOrder {
+		t.Errorf("next sequence is %s, want in order", got)
+	}
+
+	// Seeding below an inferred start leaves the sequences in between missing
+	tr.Observe("cmd-2", 5)
+	tr.Seed("cmd-2", 2)
+	want = []SequenceRange{{AggregateID: "cmd-2", From: 3, To: 4}}
+	if got := tr.Gaps("cmd-2"); !reflect.DeepEqual(got, want) {
+		t.Errorf("after Seed, Gaps() = %v, want %v", got, want)
+	}
+	if got := tr.Observe("cmd-2", 1); got != SequenceDuplicate {
+		t.Errorf("seeded sequence is %s, want duplicate", got)
+	}
+}
+
+func TestSequenceTrackerTrack(t *testing.T) {
+	tr := NewSequenceTracker()
+	e := &TacticalCommandStatusEventData{BaseEvent: BaseEvent{AggregateID: "cmd-1", Sequence: 3}}
+	if got := tr.Track(e); got != SequenceInOrder {
+		t.Errorf("first event is %s", got)
+	}
+	if got := tr.Track(&TacticalCommandStatusEventData{}); got != SequenceUntracked {
+		t.Errorf("event without sequence is %s", got)
+	}
+}
+
+func TestValidateSequence(t *testing.T) {
+	e := samplePayload(t, TacticalCommandStatusChanged)
+	e.EventBase().AggregateID = ""
+	e.EventBase().Sequence = 3
+	if err := Validate(e); err == nil {
+		t.Error("sequence without aggregateId must fail validation")
+	}
+}
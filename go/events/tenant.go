@@ -0,0 +1,98 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// Tenants.
+//
+// Producers set BaseEvent.TenantID to the tenant of the record the event is about, and
+// events derived with DeriveFrom or NewChildEvent inherit it; it travels in the tenantid
+// CloudEvents extension, so consumers can filter records without decoding them. Events
+// without a tenant belong to a single-tenant deployment.
+//
+// By default every tenant shares the topics in KafkaTopics. With
+// SetTopicNaming(TenantTopics), tenants' events go to topics prefixed with the tenant ID,
+// e.g. "agency-b.mission-events", so each tenant's topics can have their own ACLs and
+// quotas; events without a tenant stay on the shared topics.
+
+// TopicNaming decides how a tenant's events are mapped to topics
+type TopicNaming int
+
+const (
+	// SharedTopics publishes the events of every tenant to the topics in KafkaTopics
+	SharedTopics TopicNaming = iota
+	// TenantTopics publishes the events of a tenant to "<tenant>.<topic>"
+	TenantTopics
+)
+
+// tenantTopicSeparator joins a tenant ID and a topic; tenant IDs never contain it
+const tenantTopicSeparator = "."
+
+// topicNaming is the process-wide TopicNaming
+var topicNaming = struct {
+	sync.RWMutex
+	naming TopicNaming
+}{}
+
+// SetTopicNaming sets how tenants' events are mapped to topics. Every producer and
+// consumer of an environment must use the same naming.
+func SetTopicNaming(naming TopicNaming) {
+	topicNaming.Lock()
+	defer topicNaming.Unlock()
+	topicNaming.naming = naming
+}
+
+// CurrentTopicNaming returns the TopicNaming set with SetTopicNaming
+func CurrentTopicNaming() TopicNaming {
+	topicNaming.RLock()
+	defer topicNaming.RUnlock()
+	return topicNaming.naming
+}
+
+// TenantTopic returns the topic a tenant's events of a topic are published to
+func TenantTopic(tenantID, topic string) string {
+	if tenantID == "" || CurrentTopicNaming() != TenantTopics {
+		return topic
+	}
+	return tenantID + tenantTopicSeparator + topic
+}
+
+// SplitTenantTopic splits a tenant topic into the tenant ID and the topic, including its
+// retry and dead-letter suffixes. Topics without a tenant prefix return an empty tenant ID.
+func SplitTenantTopic(topic string) (tenantID, base string) {
+	prefix, rest, ok := strings.Cut(topic, tenantTopicSeparator)
+	if !ok || !models.IsValidTenantID(prefix) {
+		return "", topic
+	}
+	if _, err := TopicSpecFor(BaseTopic(rest)); err != nil {
+		return "", topic
+	}
+	return prefix, rest
+}
+
+// TopicForEvent returns the topic an event is published to: the topic of its type, for
+// its tenant
+func TopicForEvent(e Event) (string, error) {
+	base := e.EventBase()
+	topic, err := TopicFor(base.Type)
+	if err != nil {
+		return "", err
+	}
+	return TenantTopic(base.TenantID, topic), nil
+}
+
+// CheckTenant returns models.ErrCrossTenant if an event belongs to another tenant than
+// the record it is applied to
+func CheckTenant(e Event, record models.TenantScoped) error {
+	base := e.EventBase()
+	if base.TenantID != record.Tenant() {
+		return fmt.Errorf("%w: %s %s of tenant %q applied to %T of tenant %q",
+			models.ErrCrossTenant, base.Type, base.ID, base.TenantID, record, record.Tenant())
+	}
+	return nil
+}
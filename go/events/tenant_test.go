@@ -0,0 +1,122 @@
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+func TestTenantTopics(t *testing.T) {
+	e := samplePayload(t, MissionCreated)
+	e.EventBase().TenantID = "agency-b"
+	if topic, err := TopicForEvent(e); err != nil || topic != KafkaTopics.MissionEvents {
+		t.Errorf("shared naming: TopicForEvent() = %q, %v", topic, err)
+	}
+
+	SetTopicNaming(TenantTopics)
+	defer SetTopicNaming(SharedTopics)
+	msg, err := EncodeMessage(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Topic != "agency-b.mission-events" {
+		t.Errorf("tenant naming: topic %q", msg.Topic)
+	}
+	if tenant, ok := msg.Header("ce_tenantid"); !ok || tenant != "agency-b" {
+		t.Errorf("tenantid header %q", tenant)
+	}
+	decoded, err := DecodeMessage(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := decoded.(Event).EventBase().TenantID; got != "agency-b" {
+		t.Errorf("decoded tenant %q", got)
+	}
+
+	e.EventBase().TenantID = ""
+	if topic, _ := TopicForEvent(e); topic != KafkaTopics.MissionEvents {
+		t.Errorf("event without tenant went to %q", topic)
+	}
+	if got := DefaultRetryPolicy.Next("agency-b.ai-analysis", errors.New("timeout"), time.Now()).Topic; got != "agency-b.ai-analysis.retry.30s" {
+		t.Errorf("tenant retry topic %q", got)
+	}
+}
+
+func TestTenantTopicsKeepTopicCodec(t *testing.T) {
+	topic := KafkaTopics.LocationUpdates
+	SetTopicCodec(topic, ProtobufCodec)
+	SetTopicNaming(TenantTopics)
+	defer func() {
+		SetTopicNaming(SharedTopics)
+		topicCodecs.Lock()
+		delete(topicCodecs.m, topic)
+		topicCodecs.Unlock()
+	}()
+
+	if got := CodecForTopic("agency-b." + topic); got != ProtobufCodec {
+		t.Errorf("CodecForTopic(tenant topic) = %s", got.ContentType())
+	}
+	if got := CodecForTopic("agency-b." + KafkaTopics.VitalsUpdates); got != JSONCodec {
+		t.Errorf("tenant copy of a JSON topic uses %s", got.ContentType())
+	}
+
+	e := samplePayload(t, LocationUpdateEvent)
+	e.EventBase().TenantID = "agency-b"
+	msg, err := EncodeMessage(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct, _ := msg.Header(contentTypeHeader); msg.Topic != "agency-b."+topic || ct != ContentTypeProtobuf {
+		t.Errorf("record on %q has content-type %q", msg.Topic, ct)
+	}
+}
+
+func TestSplitTenantTopic(t *testing.T) {
+	cases := []struct{ topic, tenant, base string }{
+		{"agency-b.mission-events", "agency-b", "mission-events"},
+		{"agency-b.ai-analysis.retry.5m", "agency-b", "ai-analysis.retry.5m"},
+		{"mission-events", "", "mission-events"},
+		{"frame-extraction.retry.30s", "", "frame-extraction.retry.30s"},
+		{"Agency.mission-events", "", "Agency.mission-events"},
+	}
+	for _, c := range cases {
+		if tenant, base := SplitTenantTopic(c.topic); tenant != c.tenant || base != c.base {
+			t.Errorf("SplitTenantTopic(%q) = %q, %q", c.topic, tenant, base)
+		}
+	}
+}
+
+func TestCheckTenant(t *testing.T) {
+	e := samplePayload(t, TacticalCommandCreated)
+	e.EventBase().TenantID = "agency-a"
+	if err := CheckTenant(e, models.Mission{TenantID: "agency-a"}); err != nil {
+		t.Error(err)
+	}
+	if err := CheckTenant(e, models.Mission{TenantID: "agency-b"}); !errors.Is(err, models.ErrCrossTenant) {
+		t.Errorf("cross-tenant event: %v", err)
+	}
+
+	cmd := models.TacticalCommand{TenantID: "agency-a"}
+	mission := models.Mission{TenantID: "agency-a"}
+	own := models.Asset{ID: "asset-1", TenantID: "agency-a"}
+	other := models.Asset{ID: "asset-2", TenantID: "agency-b"}
+	if err := cmd.CheckTargets(mission, []models.Asset{own}, nil); err != nil {
+		t.Error(err)
+	}
+	if err := cmd.CheckTargets(mission, []models.Asset{own, other}, nil); !errors.Is(err, models.ErrCrossTenant) {
+		t.Errorf("command targeting another tenant's asset: %v", err)
+	}
+	if err := cmd.CheckTargets(models.Mission{}, nil, nil); !errors.Is(err, models.ErrCrossTenant) {
+		t.Errorf("command of a single-tenant mission: %v", err)
+	}
+}
+
+func TestValidateTenantID(t *testing.T) {
+	e := samplePayload(t, MissionCreated)
+	e.EventBase().TenantID = "Agency B"
+	if err := Validate(e); err == nil {
+		t.Error("invalid tenant ID must fail validation")
+	}
+}
@@ -2,6 +2,9 @@ package events
 
 // Kafka topic names for event routing
 // Topics are managed by Kafka admin and must be pre-created in production
+// TopicSpecFor gives the partitions, retention and cleanup policy each topic is created with
+// Use TopicFor to find the topic an EventType is published to
+// Each topic has retry and dead-letter topics named by RetryPolicy.Topics
 
 // KafkaTopics defines the Kafka topics used by the system
 var KafkaTopics = struct {
@@ -23,6 +26,7 @@ var KafkaTopics = struct {
 	MissionEvents          string
 	AIMissionSuggestions   string
 	MissionChat            string
+	FireEvents             string
 }{
 	AssetUpdates:           "asset-updates",
 	EmergencyNotifications: "emergency-notifications",
@@ -42,4 +46,5 @@ var KafkaTopics = struct {
 	MissionEvents:          "mission-events",
 	AIMissionSuggestions:   "ai-mission-suggestions",
 	MissionChat:            "mission-chat",
+	FireEvents:             "fire-events",
 }
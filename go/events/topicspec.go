@@ -0,0 +1,200 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Topic specifications. Every topic in KafkaTopics has a TopicSpec recording how it is
+// created; cmd/phlx-topics writes them, with the retry and dead-letter topics of each,
+// as the manifest environments are provisioned from.
+
+// CleanupPolicy is the Kafka cleanup.policy of a topic
+type CleanupPolicy string
+
+const (
+	CleanupDelete        CleanupPolicy = "delete"         // Drop segments older than the retention
+	CleanupCompact       CleanupPolicy = "compact"        // Keep the latest record of each key
+	CleanupCompactDelete CleanupPolicy = "compact,delete" // Keep the latest record of each key, within the retention
+)
+
+// RetainForever is the Retention of a topic whose records never expire
+const RetainForever time.Duration = -1
+
+// Retention and partitions of the topics derived from a spec
+const (
+	retryTopicRetention = 7 * 24 * time.Hour
+	dlqTopicRetention   = 30 * 24 * time.Hour
+	dlqTopicPartitions  = 1
+)
+
+// ErrNoTopicSpec is returned when a topic has no TopicSpec
+var ErrNoTopicSpec = errors.New("events: no spec for topic")
+
+// TopicSpec describes how a topic is created
+type TopicSpec struct {
+	Name              string
+	Partitions        int
+	ReplicationFactor int
+	Retention         time.Duration // RetainForever for no time limit
+	Cleanup           CleanupPolicy
+	Owner             string // Service that publishes to the topic
+}
+
+// Validate checks a spec can be created
+func (s TopicSpec) Validate() error {
+	switch {
+	case s.Name == "":
+		return errors.New("events: topic spec without name")
+	case s.Partitions < 1:
+		return fmt.Errorf("events: topic %s: partitions must be at least 1", s.Name)
+	case s.ReplicationFactor < 1:
+		return fmt.Errorf("events: topic %s: replication factor must be at least 1", s.Name)
+	case s.Retention <= 0 && s.Retention != RetainForever:
+		return fmt.Errorf("events: topic %s: retention must be positive or RetainForever", s.Name)
+	case !IsValidCleanupPolicy(s.Cleanup):
+		return fmt.Errorf("events: topic %s: invalid cleanup policy %q", s.Name, s.Cleanup)
+	case s.Owner == "":
+		return fmt.Errorf("events: topic %s: owner is required", s.Name)
+	}
+	return nil
+}
+
+// RetentionMillis returns the retention as Kafka's retention.ms, -1 for RetainForever
+func (s TopicSpec) RetentionMillis() int64 {
+	if s.Retention == RetainForever {
+		return -1
+	}
+	return s.Retention.Milliseconds()
+}
+
+// topicSpecs holds the spec of every topic, keyed by name
+var topicSpecs = struct {
+	sync.RWMutex
+	m map[string]TopicSpec
+}{m: map[string]TopicSpec{}}
+
+func init() {
+	const day = 24 * time.Hour
+	for _, s := range []TopicSpec{
+		// Not compacted: recalls share the asset key, and compaction would drop every
+		// update or recall but the latest of an asset
+		{Name: KafkaTopics.AssetUpdates, Partitions: 6, Retention: 7 * day, Cleanup: CleanupDelete, Owner: "dispatch-asset-service"},
+		{Name: KafkaTopics.EmergencyNotifications, Partitions: 3, Retention: 30 * day, Cleanup: CleanupDelete, Owner: "backend (alert service)"},
+		{Name: KafkaTopics.ChatMessages, Partitions: 3, Retention: 30 * day, Cleanup: CleanupDelete, Owner: "communication-hub-service"},
+		{Name: KafkaTopics.TacticalCommands, Partitions: 6, Retention: 30 * day, Cleanup: CleanupDelete, Owner: "mission-command-service"},
+		// Highest volume; positions are stale within minutes
+		{Name: KafkaTopics.LocationUpdates, Partitions: 12, Retention: day, Cleanup: CleanupDelete, Owner: "location-navigation-service"},
+		{Name: KafkaTopics.VitalsUpdates, Partitions: 12, Retention: 3 * day, Cleanup: CleanupDelete, Owner: "communication-hub-service"},
+		// A single key: consumers only need the current status
+		{Name: KafkaTopics.SystemStatus, Partitions: 1, Retention: RetainForever, Cleanup: CleanupCompact, Owner: "backend"},
+		{Name: KafkaTopics.VideoUploads, Partitions: 3, Retention: 7 * day, Cleanup: CleanupDelete, Owner: "video-processing-service"},
+		{Name: KafkaTopics.VideoProcessing, Partitions: 3, Retention: 7 * day, Cleanup: CleanupDelete, Owner: "video-processing-service"},
+		{Name: KafkaTopics.FrameExtraction, Partitions: 6, Retention: 3 * day, Cleanup: CleanupDelete, Owner: "video-processing-service"},
+		{Name: KafkaTopics.FrameUploadComplete, Partitions: 6, Retention: 3 * day, Cleanup: CleanupDelete, Owner: "video-processing-service"},
+		{Name: KafkaTopics.AIAnalysis, Partitions: 6, Retention: 14 * day, Cleanup: CleanupDelete, Owner: "ai-analysis-service"},
+		{Name: KafkaTopics.EventAnalysis, Partitions: 3, Retention: 14 * day, Cleanup: CleanupDelete, Owner: "ai-analysis-service"},
+		{Name: KafkaTopics.CameraEvents, Partitions: 6, Retention: 7 * day, Cleanup: CleanupDelete, Owner: "video-processing-service"},
+		{Name: KafkaTopics.Suggestions, Partitions: 3, Retention: 14 * day, Cleanup: CleanupDelete, Owner: "ai-analysis-service"},
+		// One mission_created per mission: compacted, it lists every mission for new consumers
+		{Name: KafkaTopics.MissionEvents, Partitions: 3, Retention: RetainForever, Cleanup: CleanupCompact, Owner: "mission-command-service"},
+		{Name: KafkaTopics.AIMissionSuggestions, Partitions: 3, Retention: 14 * day, Cleanup: CleanupDelete, Owner: "ai-analysis-service"},
+		{Name: KafkaTopics.MissionChat, Partitions: 3, Retention: 30 * day, Cleanup: CleanupDelete, Owner: "backend (mission chat)"},
+		{Name: KafkaTopics.FireEvents, Partitions: 3, Retention: 30 * day, Cleanup: CleanupDelete, Owner: "backend (fire risk assessment)"},
+	} {
+		s.ReplicationFactor = 3
+		topicSpecs.m[s.Name] = s
+	}
+}
+
+// ValidCleanupPolicies returns all valid cleanup policy values
+func ValidCleanupPolicies() []CleanupPolicy {
+	return []CleanupPolicy{CleanupDelete, CleanupCompact, CleanupCompactDelete}
+}
+
+// IsValidCleanupPolicy checks if a cleanup policy is valid
+func IsValidCleanupPolicy(policy CleanupPolicy) bool {
+	return contains(ValidCleanupPolicies(), policy)
+}
+
+// TopicSpecFor returns the spec of a topic
+func TopicSpecFor(topic string) (TopicSpec, error) {
+	topicSpecs.RLock()
+	defer topicSpecs.RUnlock()
+	s, ok := topicSpecs.m[topic]
+	if !ok {
+		return TopicSpec{}, fmt.Errorf("%w: %q", ErrNoTopicSpec, topic)
+	}
+	return s, nil
+}
+
+// RegisterTopicSpec adds the spec of a service-private topic.
+// It returns an error if the spec is invalid or the topic already has one.
+func RegisterTopicSpec(spec TopicSpec) error {
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+	topicSpecs.Lock()
+	defer topicSpecs.Unlock()
+	if _, ok := topicSpecs.m[spec.Name]; ok {
+		return fmt.Errorf("events: topic %q already has a spec", spec.Name)
+	}
+	topicSpecs.m[spec.Name] = spec
+	return nil
+}
+
+// TopicSpecs returns the spec of every topic, sorted by name
+func TopicSpecs() []TopicSpec {
+	topicSpecs.RLock()
+	specs := make([]TopicSpec, 0, len(topicSpecs.m))
+	for _, s := range topicSpecs.m {
+		specs = append(specs, s)
+	}
+	topicSpecs.RUnlock()
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Name < specs[j].Name })
+	return specs
+}
+
+// TopicManifest returns every topic to create: each spec followed by the retry and
+// dead-letter topics of its RetryPolicy, then the same topics for each tenant given, for
+// environments that use TenantTopics. Retry topics keep the partitions of their topic
+// and the records for a week; dead-letter topics have one partition and keep records for
+// 30 days, long enough to investigate and replay them.
+func TopicManifest(tenantIDs ...string) []TopicSpec {
+	specs := TopicSpecs()
+	manifest := manifestTopics(specs)
+	for _, tenantID := range tenantIDs {
+		tenantSpecs := make([]TopicSpec, len(specs))
+		for i, s := range specs {
+			s.Name = tenantID + tenantTopicSeparator + s.Name
+			tenantSpecs[i] = s
+		}
+		manifest = append(manifest, manifestTopics(tenantSpecs)...)
+	}
+	return manifest
+}
+
+func manifestTopics(specs []TopicSpec) []TopicSpec {
+	var manifest []TopicSpec
+	for _, s := range specs {
+		manifest = append(manifest, s)
+		for _, topic := range RetryPolicyFor(s.Name).Topics(s.Name) {
+			derived := TopicSpec{
+				Name:              topic,
+				Partitions:        s.Partitions,
+				ReplicationFactor: s.ReplicationFactor,
+				Retention:         retryTopicRetention,
+				Cleanup:           CleanupDelete,
+				Owner:             s.Owner,
+			}
+			if topic == DLQTopic(s.Name) {
+				derived.Partitions, derived.Retention = dlqTopicPartitions, dlqTopicRetention
+			}
+			manifest = append(manifest, derived)
+		}
+	}
+	return manifest
+}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEveryTopicHasSpec(t *testing.T) {
+	for _, topic := range Topics() {
+		s, err := TopicSpecFor(topic)
+		if err != nil {
+			t.Errorf("%s: %v", topic, err)
+			continue
+		}
+		if err := s.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+	if _, err := TopicSpecFor("no-such-topic"); !errors.Is(err, ErrNoTopicSpec) {
+		t.Errorf("unknown topic: %v", err)
+	}
+}
+
+func TestTopicManifest(t *testing.T) {
+	manifest := TopicManifest()
+	var names []string
+	for _, s := range manifest {
+		if s.Name == KafkaTopics.LocationUpdates || BaseTopic(s.Name) == KafkaTopics.LocationUpdates {
+			names = append(names, s.Name)
+		}
+	}
+	want := []string{"location-updates", "location-updates.retry.30s", "location-updates.retry.5m", "location-updates.dlq"}
+	if len(names) != len(want) {
+		t.Fatalf("location-updates topics %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("location-updates topics %v, want %v", names, want)
+		}
+	}
+	if len(manifest) != len(TopicSpecs())*len(want) {
+		t.Errorf("manifest has %d topics", len(manifest))
+	}
+}
+
+func TestRegisterTopicSpec(t *testing.T) {
+	spec := TopicSpec{Name: "test-topic-spec", Partitions: 1, ReplicationFactor: 1, Retention: time.Hour, Cleanup: CleanupDelete, Owner: "tests"}
+	if err := RegisterTopicSpec(spec); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterTopicSpec(spec); err == nil {
+		t.Error("registered a spec twice")
+	}
+	spec.Name, spec.Retention = "test-topic-spec-invalid", 0
+	if err := RegisterTopicSpec(spec); err == nil {
+		t.Error("registered a spec without retention")
+	}
+	if got := (TopicSpec{Retention: RetainForever}).RetentionMillis(); got != -1 {
+		t.Errorf("RetainForever is %d ms", got)
+	}
+}
@@ -0,0 +1,187 @@
+package events
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// Causality and trace context.
+//
+// Every event of an incident carries the same CorrelationID: the ID of the event
+// that started it. CausationID is the ID of the direct parent, so the chain from an
+// event_analysis detection through suggestion_created and ai_mission_suggestion to
+// tactical_command_created can be rebuilt from topic data with Lineage.
+//
+// TraceParent and TraceState follow W3C Trace Context (https://www.w3.org/TR/trace-context/).
+// A derived event continues the parent's trace with a new span ID.
+//
+//	analysis := ... // *EventAnalysisEventData read from Kafka
+//	suggestion := events.NewSuggestionCreated(events.SuggestionCreatedEventData{...})
+//	suggestion.DeriveFrom(analysis.EventBase())
+
+// ErrInvalidTraceParent is returned when a traceparent is not valid W3C Trace Context
+var ErrInvalidTraceParent = errors.New("events: invalid traceparent")
+
+// traceParentLength is the length of a version 00 traceparent
+const traceParentLength = 55
+
+// TraceContext is a parsed W3C traceparent
+type TraceContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Flags   byte
+}
+
+// NewTraceContext starts a new sampled trace
+func NewTraceContext() TraceContext {
+	var tc TraceContext
+	randomID(tc.TraceID[:])
+	randomID(tc.SpanID[:])
+	tc.Flags = 0x01
+	return tc
+}
+
+// ParseTraceParent parses a traceparent header value
+func ParseTraceParent(s string) (TraceContext, error) {
+	var tc TraceContext
+	if len(s) < traceParentLength || s[2] != '-' || s[35] != '-' || s[52] != '-' {
+		return tc, fmt.Errorf("%w: %q", ErrInvalidTraceParent, s)
+	}
+	version, err := decodeLowerHex(s[0:2])
+	if err != nil || version[0] == 0xff {
+		return tc, fmt.Errorf("%w: version in %q", ErrInvalidTraceParent, s)
+	}
+	// Version 00 has exactly four fields; later versions may append more
+	if (version[0] == 0 && len(s) != traceParentLength) || (len(s) > traceParentLength && s[traceParentLength] != '-') {
+		return tc, fmt.Errorf("%w: %q", ErrInvalidTraceParent, s)
+	}
+
+	traceID, err := decodeLowerHex(s[3:35])
+	if err != nil || isZero(traceID) {
+		return tc, fmt.Errorf("%w: trace-id in %q", ErrInvalidTraceParent, s)
+	}
+	spanID, err := decodeLowerHex(s[36:52])
+	if err != nil || isZero(spanID) {
+		return tc, fmt.Errorf("%w: parent-id in %q", ErrInvalidTraceParent, s)
+	}
+	flags, err := decodeLowerHex(s[53:55])
+	if err != nil {
+		return tc, fmt.Errorf("%w: trace-flags in %q", ErrInvalidTraceParent, s)
+	}
+	copy(tc.TraceID[:], traceID)
+	copy(tc.SpanID[:], spanID)
+	tc.Flags = flags[0]
+	return tc, nil
+}
+
+// String formats the context as a version 00 traceparent
+func (tc TraceContext) String() string {
+	return fmt.Sprintf("00-%s-%s-%02x", hex.EncodeToString(tc.TraceID[:]), hex.EncodeToString(tc.SpanID[:]), tc.Flags)
+}
+
+// Sampled reports whether the sampled flag is set
+func (tc TraceContext) Sampled() bool {
+	return tc.Flags&0x01 != 0
+}
+
+// Child returns a context for a new span in the same trace
+func (tc TraceContext) Child() TraceContext {
+	child := tc
+	randomID(child.SpanID[:])
+	return child
+}
+
+// DeriveFrom records parent as the cause of e: e joins the parent's incident, trace and
+// tenant
+func (e *BaseEvent) DeriveFrom(parent *BaseEvent) {
+	if parent.TenantID != "" {
+		e.TenantID = parent.TenantID
+	}
+	e.CorrelationID = parent.CorrelationID
+	if e.CorrelationID == "" {
+		// The parent predates correlation IDs, so it is the root
+		e.CorrelationID = parent.ID
+	}
+	e.CausationID = parent.ID
+	e.TraceParent, e.TraceState = "", ""
+	if tc, err := ParseTraceParent(parent.TraceParent); err == nil {
+		e.TraceParent = tc.Child().String()
+		e.TraceState = parent.TraceState
+	}
+}
+
+// NewChildEvent returns the metadata for a new event caused by parent
+func NewChildEvent(eventType EventType, parent *BaseEvent) BaseEvent {
+	base := NewBaseEvent(eventType)
+	base.DeriveFrom(parent)
+	return base
+}
+
+// Lineage returns the chain of causes of the event with the given ID, from the root
+// of the incident to the event itself. The chain stops early at a cause missing from events.
+func Lineage(events []Event, id string) []Event {
+	byID := make(map[string]Event, len(events))
+	for _, e := range events {
+		byID[e.EventBase().ID] = e
+	}
+
+	var chain []Event
+	seen := map[string]bool{}
+	for id != "" && !seen[id] {
+		e, ok := byID[id]
+		if !ok {
+			break
+		}
+		seen[id] = true
+		chain = append(chain, e)
+		id = e.EventBase().CausationID
+	}
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain
+}
+
+// Incident returns the events that share the correlation ID, in input order
+func Incident(events []Event, correlationID string) []Event {
+	var out []Event
+	for _, e := range events {
+		base := e.EventBase()
+		if base.CorrelationID == correlationID || (base.CorrelationID == "" && base.ID == correlationID) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func randomID(b []byte) {
+	for {
+		if _, err := rand.Read(b); err != nil {
+			panic("events: read trace ID entropy: " + err.Error())
+		}
+		if !isZero(b) {
+			return
+		}
+	}
+}
+
+// decodeLowerHex decodes hex, rejecting upper case as Trace Context requires
+func decodeLowerHex(s string) ([]byte, error) {
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return nil, ErrInvalidTraceParent
+		}
+	}
+	return hex.DecodeString(s)
+}
+
+func isZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
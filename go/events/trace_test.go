@@ -0,0 +1,96 @@
+package events
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDeriveFromBuildsIncidentChain(t *testing.T) {
+	root := NewEventAnalysis(EventAnalysisEventData{VideoID: "video-1"})
+	root.TraceParent = NewTraceContext().String()
+	root.TraceState = "phlx=1"
+
+	suggestion := NewSuggestionCreated(SuggestionCreatedEventData{EventID: root.ID})
+	suggestion.DeriveFrom(root.EventBase())
+	aiSuggestion := NewAIMissionSuggestion(AIMissionSuggestionEventData{})
+	aiSuggestion.DeriveFrom(suggestion.EventBase())
+	command := NewTacticalCommandCreated(TacticalCommandCreatedEventData{})
+	command.BaseEvent = NewChildEvent(TacticalCommandCreated, aiSuggestion.EventBase())
+	unrelated := NewMissionCreated(MissionCreatedEventData{})
+
+	if command.CorrelationID != root.ID || command.CausationID != aiSuggestion.ID {
+		t.Errorf("correlation/causation = %q/%q, want %q/%q", command.CorrelationID, command.CausationID, root.ID, aiSuggestion.ID)
+	}
+
+	rootTrace, _ := ParseTraceParent(root.TraceParent)
+	commandTrace, err := ParseTraceParent(command.TraceParent)
+	if err != nil {
+		t.Fatalf("derived traceparent: %v", err)
+	}
+	if commandTrace.TraceID != rootTrace.TraceID || commandTrace.SpanID == rootTrace.SpanID {
+		t.Errorf("derived trace %s should continue %s with a new span", command.TraceParent, root.TraceParent)
+	}
+	if command.TraceState != "phlx=1" {
+		t.Errorf("tracestate = %q", command.TraceState)
+	}
+
+	topic := []Event{unrelated, command, suggestion, root, aiSuggestion}
+	chain := Lineage(topic, command.ID)
+	want := []string{root.ID, suggestion.ID, aiSuggestion.ID, command.ID}
+	if len(chain) != len(want) {
+		t.Fatalf("lineage has %d events, want %d", len(chain), len(want))
+	}
+	for i, e := range chain {
+		if e.EventBase().ID != want[i] {
+			t.Errorf("lineage[%d] = %s, want %s", i, e.EventBase().ID, want[i])
+		}
+	}
+	if got := Incident(topic, root.ID); len(got) != 4 {
+		t.Errorf("incident has %d events, want 4", len(got))
+	}
+}
+
+func TestParseTraceParent(t *testing.T) {
+	valid := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	tc, err := ParseTraceParent(valid)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if tc.String() != valid || !tc.Sampled() {
+		t.Errorf("round trip = %s, sampled = %v", tc, tc.Sampled())
+	}
+
+	for _, s := range []string{
+		"",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01", // upper case
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01", // zero trace-id
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", // zero parent-id
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", // invalid version
+		valid + "-extra", // version 00 has four fields
+	} {
+		if _, err := ParseTraceParent(s); !errors.Is(err, ErrInvalidTraceParent) {
+			t.Errorf("ParseTraceParent(%q) = %v, want ErrInvalidTraceParent", s, err)
+		}
+	}
+	if _, err := ParseTraceParent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-future"); err != nil {
+		t.Errorf("later versions may append fields: %v", err)
+	}
+}
+
+func TestValidateTraceFields(t *testing.T) {
+	e := samplePayload(t, ChatMessageEvent).(*ChatMessageEventData)
+	e.Sender = "user"
+	e.TraceParent = "not-a-traceparent"
+	e.CorrelationID = ""
+	var verrs ValidationErrors
+	if !errors.As(Validate(e), &verrs) {
+		t.Fatal("expected validation errors")
+	}
+	fields := map[string]bool{}
+	for _, fe := range verrs {
+		fields[fe.Field] = true
+	}
+	if !fields["traceparent"] || !fields["correlationId"] {
+		t.Errorf("expected traceparent and correlationId errors, got %v", verrs)
+	}
+}
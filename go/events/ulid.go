@@ -0,0 +1,104 @@
+package events
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ULIDs (https://github.com/ulid/spec) are 26-character IDs that sort by creation
+// time: a 48-bit millisecond timestamp followed by 80 random bits, in Crockford base32.
+// IDs created in the same millisecond by one process increment the random part, so
+// they still sort in creation order.
+
+// ErrInvalidULID is returned when a string is not a valid ULID
+var ErrInvalidULID = errors.New("events: invalid ULID")
+
+const (
+	ulidLength   = 26
+	ulidAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	ulidMaxTime  = 1<<48 - 1
+)
+
+// ulids is the process-wide monotonic ULID state
+var ulids = struct {
+	sync.Mutex
+	ms      uint64
+	entropy [10]byte
+}{}
+
+// NewULID returns a new ULID for the current time of the configured clock
+func NewULID() string {
+	return newULIDAt(now())
+}
+
+// newULIDAt returns a ULID for t that sorts after every ULID this process created before
+func newULIDAt(t time.Time) string {
+	ms := uint64(t.UnixMilli())
+	if t.UnixMilli() < 0 {
+		ms = 0
+	}
+
+	ulids.Lock()
+	defer ulids.Unlock()
+	if ms <= ulids.ms {
+		// Same millisecond, or the clock went backwards: increment the previous ID
+		ms = ulids.ms
+		if !incrementEntropy(&ulids.entropy) {
+			ms++ // 2^80 IDs in one millisecond: borrow the next one
+		}
+	} else if _, err := rand.Read(ulids.entropy[:]); err != nil {
+		panic("events: read ULID entropy: " + err.Error())
+	}
+	ulids.ms = ms
+
+	var id [16]byte
+	binary.BigEndian.PutUint64(id[:8], ms<<16)
+	copy(id[6:], ulids.entropy[:])
+	return encodeULID(id)
+}
+
+// incrementEntropy adds one to the random part; it reports false on overflow
+func incrementEntropy(b *[10]byte) bool {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i]++
+		if b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func encodeULID(id [16]byte) string {
+	hi := binary.BigEndian.Uint64(id[:8])
+	lo := binary.BigEndian.Uint64(id[8:])
+	var dst [ulidLength]byte
+	for i := ulidLength - 1; i >= 0; i-- {
+		dst[i] = ulidAlphabet[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(dst[:])
+}
+
+// ULIDTime returns the creation time encoded in a ULID
+func ULIDTime(id string) (time.Time, error) {
+	if len(id) != ulidLength || id[0] > '7' {
+		return time.Time{}, ErrInvalidULID
+	}
+	var ms uint64
+	for _, c := range strings.ToUpper(id[:10]) {
+		n := strings.IndexRune(ulidAlphabet, c)
+		if n < 0 {
+			return time.Time{}, ErrInvalidULID
+		}
+		ms = ms<<5 | uint64(n)
+	}
+	if strings.IndexFunc(strings.ToUpper(id[10:]), func(c rune) bool { return !strings.ContainsRune(ulidAlphabet, c) }) >= 0 {
+		return time.Time{}, ErrInvalidULID
+	}
+	return time.UnixMilli(int64(ms & ulidMaxTime)).UTC(), nil
+}
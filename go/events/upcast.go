@@ -0,0 +1,190 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultSchemaVersion is assumed for events published before BaseEvent carried a version
+const DefaultSchemaVersion = "1.0"
+
+// maxUpcastSteps guards against cycles in a misconfigured upcaster chain
+const maxUpcastSteps = 32
+
+// Upcaster rewrites the JSON fields of an event from one schema version to the next.
+// Numbers are decoded as json.Number so integer fields survive unchanged.
+type Upcaster func(fields map[string]any) (map[string]any, error)
+
+type upcastStep struct {
+	to string
+	up Upcaster
+}
+
+type upcastKey struct {
+	eventType EventType
+	from      string
+}
+
+// upcasters holds the registered version steps for each event type
+var upcasters = struct {
+	sync.RWMutex
+	steps map[upcastKey]upcastStep
+}{steps: map[upcastKey]upcastStep{}}
+
+// RegisterUpcaster adds a step that turns a payload of version from into version to.
+// Steps chain, so 1.0 → 2.0 and 2.0 → 3.0 together upcast 1.0 payloads to 3.0. A step
+// must move to a newer version.
+func RegisterUpcaster(eventType EventType, from, to string, up Upcaster) error {
+	cmp, err := compareSchemaVersions(from, to)
+	if err != nil {
+		return fmt.Errorf("events: upcaster for %q: %w", eventType, err)
+	}
+	if cmp >= 0 {
+		return fmt.Errorf("events: upcaster for %q must move to a newer version, not %s → %s", eventType, from, to)
+	}
+	upcasters.Lock()
+	defer upcasters.Unlock()
+
+	key := upcastKey{eventType: eventType, from: from}
+	if existing, ok := upcasters.steps[key]; ok {
+		return fmt.Errorf("events: %q already has an upcaster from %s to %s", eventType, from, existing.to)
+	}
+	upcasters.steps[key] = upcastStep{to: to, up: up}
+	return nil
+}
+
+// MustRegisterUpcaster is like RegisterUpcaster but panics on error
+func MustRegisterUpcaster(eventType EventType, from, to string, up Upcaster) {
+	if err := RegisterUpcaster(eventType, from, to, up); err != nil {
+		panic(err)
+	}
+}
+
+// CurrentSchemaVersion returns the version producers should stamp on an event type,
+// i.e. the end of its upcaster chain
+func CurrentSchemaVersion(eventType EventType) string {
+	upcasters.RLock()
+	defer upcasters.RUnlock()
+
+	version := DefaultSchemaVersion
+	for i := 0; i < maxUpcastSteps; i++ {
+		step, ok := upcasters.steps[upcastKey{eventType: eventType, from: version}]
+		if !ok {
+			break
+		}
+		version = step.to
+	}
+	return version
+}
+
+// Upcast rewrites raw event JSON to the current schema version of its event type.
+// Events that are already current are returned unchanged.
+func Upcast(data []byte) ([]byte, error) {
+	var head struct {
+		Type          EventType `json:"type"`
+		SchemaVersion string    `json:"schemaVersion"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, fmt.Errorf("events: decode event header: %w", err)
+	}
+	version := head.SchemaVersion
+	if version == "" {
+		version = DefaultSchemaVersion
+	}
+
+	upcasters.RLock()
+	_, pending := upcasters.steps[upcastKey{eventType: head.Type, from: version}]
+	upcasters.RUnlock()
+	if !pending {
+		return data, nil
+	}
+
+	var fields map[string]any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("events: decode %q for upcast: %w", head.Type, err)
+	}
+
+	for i := 0; ; i++ {
+		if i == maxUpcastSteps {
+			return nil, fmt.Errorf("events: upcaster chain for %q exceeds %d steps", head.Type, maxUpcastSteps)
+		}
+		upcasters.RLock()
+		step, ok := upcasters.steps[upcastKey{eventType: head.Type, from: version}]
+		upcasters.RUnlock()
+		if !ok {
+			break
+		}
+
+		var err error
+		fields, err = step.up(fields)
+		if err != nil {
+			return nil, fmt.Errorf("events: upcast %q from %s to %s: %w", head.Type, version, step.to, err)
+		}
+		if fields == nil {
+			return nil, fmt.Errorf("events: upcast %q from %s to %s returned no fields", head.Type, version, step.to)
+		}
+		version = step.to
+	}
+
+	fields["schemaVersion"] = version
+	return json.Marshal(fields)
+}
+
+// compareSchemaVersions compares two dotted numeric versions such as "1.0" and "1.10",
+// returning -1, 0 or 1. Missing trailing components count as zero.
+func compareSchemaVersions(a, b string) (int, error) {
+	x, err := parseSchemaVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	y, err := parseSchemaVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for len(x) < len(y) {
+		x = append(x, 0)
+	}
+	for len(y) < len(x) {
+		y = append(y, 0)
+	}
+	for i := range x {
+		switch {
+		case x[i] < y[i]:
+			return -1, nil
+		case x[i] > y[i]:
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// parseSchemaVersion splits a dotted numeric version into its components
+func parseSchemaVersion(version string) ([]int, error) {
+	parts := strings.Split(version, ".")
+	nums := make([]int, len(parts))
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid schema version %q", version)
+		}
+		nums[i] = n
+	}
+	return nums, nil
+}
+
+// RenameField returns an Upcaster that moves a JSON field to a new name
+func RenameField(from, to string) Upcaster {
+	return func(fields map[string]any) (map[string]any, error) {
+		if v, ok := fields[from]; ok {
+			fields[to] = v
+			delete(fields, from)
+		}
+		return fields, nil
+	}
+}
@@ -0,0 +1,138 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testUpcasts returns an event type of its own for a test and removes its upcasters
+// when the test ends
+func testUpcasts(t *testing.T) EventType {
+	eventType := EventType("upcast_test." + t.Name())
+	t.Cleanup(func() {
+		upcasters.Lock()
+		defer upcasters.Unlock()
+		for key := range upcasters.steps {
+			if key.eventType == eventType {
+				delete(upcasters.steps, key)
+			}
+		}
+	})
+	return eventType
+}
+
+func setDefault(field string, value any) Upcaster {
+	return func(fields map[string]any) (map[string]any, error) {
+		if _, ok := fields[field]; !ok {
+			fields[field] = value
+		}
+		return fields, nil
+	}
+}
+
+func upcastFields(t *testing.T, data string) map[string]any {
+	t.Helper()
+	out, err := Upcast([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(out, &fields); err != nil {
+		t.Fatal(err)
+	}
+	return fields
+}
+
+func TestUpcastChain(t *testing.T) {
+	eventType := testUpcasts(t)
+	MustRegisterUpcaster(eventType, "1.0", "2.0", RenameField("name", "title"))
+	MustRegisterUpcaster(eventType, "2.0", "3.0", setDefault("priority", "normal"))
+	if got := CurrentSchemaVersion(eventType); got != "3.0" {
+		t.Errorf("CurrentSchemaVersion = %s, want 3.0", got)
+	}
+
+	// Payloads without a version are 1.0 and go through every step
+	got := upcastFields(t, `{"type":"`+string(eventType)+`","name":"Fire","count":9007199254740993}`)
+	if got["title"] != "Fire" || got["name"] != nil || got["priority"] != "normal" || got["schemaVersion"] != "3.0" {
+		t.Errorf("upcast from 1.0: %v", got)
+	}
+	out, _ := Upcast([]byte(`{"type":"` + string(eventType) + `","count":9007199254740993}`))
+	if !strings.Contains(string(out), `"count":9007199254740993`) {
+		t.Errorf("integer changed by upcasting: %s", out)
+	}
+
+	got = upcastFields(t, `{"type":"`+string(eventType)+`","schemaVersion":"2.0","name":"kept","priority":"high"}`)
+	if got["name"] != "kept" || got["priority"] != "high" || got["schemaVersion"] != "3.0" {
+		t.Errorf("upcast from 2.0: %v", got)
+	}
+
+	current := `{"type":"` + string(eventType) + `","schemaVersion":"3.0","name":"kept"}`
+	if out, err := Upcast([]byte(current)); err != nil || string(out) != current {
+		t.Errorf("current payload changed: %s, %v", out, err)
+	}
+}
+
+func TestUpcastMissingStep(t *testing.T) {
+	eventType := testUpcasts(t)
+	MustRegisterUpcaster(eventType, "1.0", "2.0", setDefault("priority", "normal"))
+	MustRegisterUpcaster(eventType, "3.0", "4.0", setDefault("team", "none"))
+
+	// The chain stops where no step continues it
+	if got := CurrentSchemaVersion(eventType); got != "2.0" {
+		t.Errorf("CurrentSchemaVersion = %s, want 2.0", got)
+	}
+	got := upcastFields(t, `{"type":"`+string(eventType)+`","schemaVersion":"1.0"}`)
+	if got["priority"] != "normal" || got["team"] != nil || got["schemaVersion"] != "2.0" {
+		t.Errorf("upcast across a gap: %v", got)
+	}
+
+	// Versions without a step, including ones newer than this consumer knows, pass through
+	for _, version := range []string{"1.5", "9.0"} {
+		data := `{"type":"` + string(eventType) + `","schemaVersion":"` + version + `"}`
+		if out, err := Upcast([]byte(data)); err != nil || string(out) != data {
+			t.Errorf("version %s: %s, %v", version, out, err)
+		}
+	}
+}
+
+func TestRegisterUpcasterRejectsDowngrade(t *testing.T) {
+	eventType := testUpcasts(t)
+	noop := RenameField("a", "b")
+	for _, tt := range []struct{ from, to string }{
+		{"2.0", "1.0"},
+		{"1.0", "1.0"},
+		{"1.10", "1.9"},
+		{"1.0", "1"},
+		{"1.x", "2.0"},
+		{"1.0", ""},
+	} {
+		if err := RegisterUpcaster(eventType, tt.from, tt.to, noop); err == nil {
+			t.Errorf("RegisterUpcaster(%s → %s) succeeded", tt.from, tt.to)
+		}
+	}
+	if err := RegisterUpcaster(eventType, "1.9", "1.10", noop); err != nil {
+		t.Errorf("1.9 → 1.10: %v", err)
+	}
+	if err := RegisterUpcaster(eventType, "1.9", "2.0", noop); err == nil {
+		t.Error("a second step from 1.9 was accepted")
+	}
+}
+
+func TestUpcastErrors(t *testing.T) {
+	eventType := testUpcasts(t)
+	broken := errors.New("broken step")
+	MustRegisterUpcaster(eventType, "1.0", "2.0", func(map[string]any) (map[string]any, error) { return nil, broken })
+	MustRegisterUpcaster(eventType, "2.0", "3.0", func(map[string]any) (map[string]any, error) { return nil, nil })
+
+	if _, err := Upcast([]byte(`{"type":"` + string(eventType) + `"}`)); !errors.Is(err, broken) {
+		t.Errorf("failing step: %v", err)
+	}
+	if _, err := Upcast([]byte(`{"type":"` + string(eventType) + `","schemaVersion":"2.0"}`)); err == nil || !strings.Contains(err.Error(), "returned no fields") {
+		t.Errorf("step returning nil: %v", err)
+	}
+	if _, err := Upcast([]byte(`not json`)); err == nil {
+		t.Error("Upcast accepted invalid JSON")
+	}
+}
@@ -0,0 +1,504 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// Validator is implemented by every payload; producers call Validate before publishing
+type Validator interface {
+	Validate() error
+}
+
+// FieldError describes one invalid field, addressed by its JSON path (e.g. "location.latitude")
+type FieldError = models.FieldError
+
+// ValidationErrors collects every invalid field of a payload
+type ValidationErrors = models.ValidationErrors
+
+// Validate validates a payload if it implements Validator
+func Validate(payload any) error {
+	if v, ok := payload.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// Allowed enum values for payload fields that are typed as plain strings
+var (
+	validSeverities        = []string{"low", "medium", "high", "critical"}
+	validDecisions         = []string{"accepted", "rejected"}
+	validJobTypes          = []string{"frame_extraction", "ai_analysis", "transcoding"}
+	validJobStatuses       = []string{"pending", "running", "completed", "failed"}
+	validChatSenders       = []string{"user", "system", "update", "recommendation"}
+	validSystemStatuses    = []string{"Normal", "Emergency", "Maintenance"}
+	validAnalysisCategory  = []string{"security", "safety", "emergency", "normal"}
+	validTargetTypes       = []string{"team", "asset"}
+	validCommandSources    = []string{"ai", "operator"}
+	validMissionPriorities = []string{"low", "medium", "high", "critical"}
+	validChatSenderRoles   = []string{"operator", "field_agent"}
+	validAssetStatuses     = models.ValidAssetStatuses()
+)
+
+// validator accumulates field errors while a payload is checked
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) fail(field, format string, args ...any) {
+	v.errs = append(v.errs, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.fail(field, "is required")
+	}
+}
+
+func (v *validator) oneOf(field, value string, allowed []string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.fail(field, "must be one of %s, got %q", strings.Join(allowed, ", "), value)
+}
+
+// optionalOneOf checks the enum only when the field is set
+func (v *validator) optionalOneOf(field, value string, allowed []string) {
+	if value != "" {
+		v.oneOf(field, value, allowed)
+	}
+}
+
+func (v *validator) between(field string, value, min, max float64) {
+	if value < min || value > max {
+		v.fail(field, "must be between %g and %g, got %g", min, max, value)
+	}
+}
+
+func (v *validator) commandStatus(field, value string) {
+	if !models.IsValidStatus(models.TacticalCommandStatus(value)) {
+		v.fail(field, "must be a valid tactical command status, got %q", value)
+	}
+}
+
+func (v *validator) base(e BaseEvent, want EventType) {
+	v.required("id", e.ID)
+	if e.Type != want {
+		v.fail("type", "must be %q, got %q", want, e.Type)
+	}
+	v.required("source", e.Source)
+	v.trace(e)
+	v.sequence(e)
+	if e.TenantID != "" && !models.IsValidTenantID(e.TenantID) {
+		v.fail("tenantId", "must be lowercase letters, digits and hyphens, got %q", e.TenantID)
+	}
+	v.encryption(e.Encryption)
+}
+
+// encryption checks that encrypted fields can be decrypted by a key holder
+func (v *validator) encryption(enc *FieldEncryption) {
+	if enc == nil {
+		return
+	}
+	v.required("encryption.keyId", enc.KeyID)
+	if len(enc.DataKey) == 0 {
+		v.fail("encryption.dataKey", "is required")
+	}
+	if len(enc.Fields) == 0 {
+		v.fail("encryption.fields", "must list the encrypted fields")
+	}
+	if len(enc.Ciphertext) == 0 {
+		v.fail("encryption.ciphertext", "is required")
+	}
+}
+
+// trace checks the causality and W3C Trace Context fields
+func (v *validator) trace(e BaseEvent) {
+	if e.CausationID != "" && e.CorrelationID == "" {
+		v.fail("correlationId", "is required when causationId is set")
+	}
+	if e.TraceParent != "" {
+		if _, err := ParseTraceParent(e.TraceParent); err != nil {
+			v.fail("traceparent", "must be a W3C traceparent, got %q", e.TraceParent)
+		}
+	} else if e.TraceState != "" {
+		v.fail("tracestate", "requires traceparent")
+	}
+}
+
+// sequence checks the per-aggregate ordering fields
+func (v *validator) sequence(e BaseEvent) {
+	if e.Sequence < 0 {
+		v.fail("sequence", "must not be negative")
+	}
+	if e.Sequence != 0 && e.AggregateID == "" {
+		v.fail("aggregateId", "is required when sequence is set")
+	}
+}
+
+func (v *validator) coordinates(field string, lat, lng float64) {
+	v.between(field+".latitude", lat, -90, 90)
+	v.between(field+".longitude", lng, -180, 180)
+}
+
+func (v *validator) location(field string, loc *LocationData) {
+	if loc != nil {
+		v.coordinates(field, loc.Latitude, loc.Longitude)
+	}
+}
+
+func (v *validator) geoLocation(field string, loc *TacticalGeoLocation) {
+	if loc == nil {
+		return
+	}
+	// TacticalGeoLocation uses short JSON names
+	v.between(field+".lat", loc.Latitude, -90, 90)
+	v.between(field+".lng", loc.Longitude, -180, 180)
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// Validate checks required fields and asset status values
+func (e *AssetUpdateEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, AssetUpdateEvent)
+	v.required("assetId", e.AssetID)
+	v.optionalOneOf("oldStatus", e.OldStatus, validAssetStatuses)
+	v.oneOf("newStatus", e.NewStatus, validAssetStatuses)
+	v.location("location", e.Location)
+	return v.err()
+}
+
+// Validate checks the recall reason, return base and deadline
+func (e *AssetRecallEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, AssetRecallEvent)
+	v.required("assetId", e.AssetID)
+	if !models.IsValidRecallReason(models.AssetRecallReason(e.Reason)) {
+		v.fail("reason", "must be a valid recall reason, got %q", e.Reason)
+	}
+	if models.AssetRecallReason(e.Reason) == models.RecallReasonOperatorOrder {
+		v.required("notes", e.Notes)
+	}
+	v.required("returnBaseId", e.ReturnBaseID)
+	if e.ReturnBy.IsZero() {
+		v.fail("returnBy", "is required")
+	} else if !e.Timestamp.IsZero() && e.ReturnBy.Before(e.Timestamp) {
+		v.fail("returnBy", "must not be before the event timestamp")
+	}
+	v.required("recalledBy", e.RecalledBy)
+	v.location("location", e.Location)
+	return v.err()
+}
+
+// Validate checks required fields, severity and coordinates
+func (e *EmergencyNotificationEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, EmergencyNotification)
+	v.required("notificationId", e.NotificationID)
+	v.required("title", e.Title)
+	v.oneOf("severity", e.Severity, validSeverities)
+	if e.RecipientCount < 0 {
+		v.fail("recipientCount", "must not be negative")
+	}
+	v.location("coordinates", e.Coordinates)
+	if e.Acknowledged {
+		v.required("acknowledgedBy", e.AcknowledgedBy)
+	}
+	return v.err()
+}
+
+// Validate checks required fields and the sender kind
+func (e *ChatMessageEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, ChatMessageEvent)
+	v.required("messageId", e.MessageID)
+	v.oneOf("sender", e.Sender, validChatSenders)
+	return v.err()
+}
+
+// Validate checks required fields, coordinates and heading
+func (e *LocationUpdateEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, LocationUpdateEvent)
+	v.required("assetId", e.AssetID)
+	if e.Location == nil {
+		v.fail("location", "is required")
+	}
+	v.location("location", e.Location)
+	if e.Speed < 0 {
+		v.fail("speed", "must not be negative")
+	}
+	v.between("heading", e.Heading, 0, 360)
+	return v.err()
+}
+
+// Validate checks vital sign ranges; a missing pulse is only allowed on an alert or when
+// the pulse is encrypted, and an alert needs a reason unless the reason is encrypted
+func (e *VitalsUpdateEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, VitalsUpdateEvent)
+	v.required("personnelId", e.PersonnelID)
+	if e.PulseRate <= 0 && !e.IsAlert && !e.Encryption.Has("pulseRate") {
+		v.fail("pulseRate", "must be positive unless isAlert is set, got %d", e.PulseRate)
+	}
+	v.between("pulseRate", float64(e.PulseRate), 0, 300)
+	v.between("oxygenLevel", float64(e.OxygenLevel), 0, 100)
+	if e.IsAlert && !e.Encryption.Has("alertReason") {
+		v.required("alertReason", e.AlertReason)
+	}
+	return v.err()
+}
+
+// Validate checks the system status values
+func (e *SystemStatusEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, SystemStatusEvent)
+	v.oneOf("status", e.Status, validSystemStatuses)
+	v.optionalOneOf("previousStatus", e.PreviousStatus, validSystemStatuses)
+	v.required("changedBy", e.ChangedBy)
+	if e.ActiveAssets < 0 {
+		v.fail("activeAssets", "must not be negative")
+	}
+	if e.DispatchedAssets < 0 {
+		v.fail("dispatchedAssets", "must not be negative")
+	}
+	return v.err()
+}
+
+// Validate checks required fields and sizes
+func (e *VideoUploadEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, VideoUploadEvent)
+	v.required("videoId", e.VideoID)
+	v.required("gcsPath", e.GCSPath)
+	if e.Duration < 0 {
+		v.fail("duration", "must not be negative")
+	}
+	if e.FileSize < 0 {
+		v.fail("fileSize", "must not be negative")
+	}
+	v.location("location", e.Location)
+	return v.err()
+}
+
+// Validate checks job type, status and 0-100 progress
+func (e *VideoProcessingEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, VideoProcessingEvent)
+	v.required("videoId", e.VideoID)
+	v.oneOf("jobType", e.JobType, validJobTypes)
+	v.oneOf("status", e.Status, validJobStatuses)
+	v.between("progress", e.Progress, 0, 100)
+	if e.Status == "failed" {
+		v.required("errorMsg", e.ErrorMsg)
+	}
+	return v.err()
+}
+
+// Validate checks required fields and frame position
+func (e *FrameExtractionEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, FrameExtractionEvent)
+	v.required("videoId", e.VideoID)
+	v.required("frameId", e.FrameID)
+	if e.FrameNumber < 0 {
+		v.fail("frameNumber", "must not be negative")
+	}
+	if e.Timestamp < 0 {
+		v.fail("timestamp", "must not be negative")
+	}
+	v.location("location", e.Location)
+	return v.err()
+}
+
+// Validate checks required fields and frame position
+func (e *FrameUploadCompleteEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, FrameUploadCompleteEvent)
+	v.required("videoId", e.VideoID)
+	v.required("frameId", e.FrameID)
+	if e.FrameNumber < 0 {
+		v.fail("frameNumber", "must not be negative")
+	}
+	if e.RetryCount < 0 {
+		v.fail("retryCount", "must not be negative")
+	}
+	v.location("location", e.Location)
+	return v.err()
+}
+
+// Validate checks confidence bounds of the analysis and each detection
+func (e *AIAnalysisEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, AIAnalysisEvent)
+	v.required("videoId", e.VideoID)
+	v.required("frameId", e.FrameID)
+	v.between("confidence", e.Confidence, 0, 100)
+	for i, o := range e.Objects {
+		v.between(fmt.Sprintf("objects[%d].confidence", i), o.Confidence, 0, 100)
+	}
+	for i, d := range e.Events {
+		field := fmt.Sprintf("events[%d]", i)
+		v.between(field+".confidence", d.Confidence, 0, 100)
+		v.optionalOneOf(field+".severity", d.Severity, validSeverities)
+		v.location(field+".location", d.Location)
+	}
+	return v.err()
+}
+
+// Validate checks severity, category and confidence
+func (e *EventAnalysisEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, EventAnalysisEvent)
+	v.required("videoId", e.VideoID)
+	v.required("frameId", e.FrameID)
+	v.between("confidence", e.Confidence, 0, 100)
+	v.oneOf("severity", e.Severity, validSeverities)
+	v.optionalOneOf("category", e.Category, validAnalysisCategory)
+	v.location("location", e.Location)
+	return v.err()
+}
+
+// Validate checks required IDs and confidence
+func (e *SuggestionCreatedEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, SuggestionCreated)
+	v.required("suggestionId", e.SuggestionID)
+	v.required("eventId", e.EventID)
+	v.required("missionId", e.MissionID)
+	v.between("confidence", e.Confidence, 0, 100)
+	return v.err()
+}
+
+// Validate checks required fields, mission status and priority
+func (e *MissionCreatedEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, MissionCreated)
+	v.required("missionId", e.MissionID)
+	v.required("title", e.Title)
+	v.oneOf("priority", e.Priority, validMissionPriorities)
+	if !models.IsValidMissionStatus(models.MissionStatus(e.Status)) {
+		v.fail("status", "must be a valid mission status, got %q", e.Status)
+	}
+	v.location("location", e.Location)
+	v.required("createdBy", e.CreatedBy)
+	return v.err()
+}
+
+// Validate checks confidence and each suggested command
+func (e *AIMissionSuggestionEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, AIMissionSuggestion)
+	v.required("missionId", e.MissionID)
+	v.between("confidence", e.Confidence, 0, 100)
+	for i, c := range e.TacticalCommands {
+		field := fmt.Sprintf("tacticalCommands[%d]", i)
+		v.required(field+".title", c.Title)
+		v.oneOf(field+".targetType", c.TargetType, validTargetTypes)
+		if !models.IsValidCategory(models.TacticalCommandCategory(c.Category)) {
+			v.fail(field+".category", "must be a valid tactical command category, got %q", c.Category)
+		}
+		if !models.IsValidPriority(models.TacticalCommandPriority(c.Priority)) {
+			v.fail(field+".priority", "must be a valid tactical command priority, got %q", c.Priority)
+		}
+	}
+	return v.err()
+}
+
+// Validate checks required IDs, targets, category, priority and geometry
+func (e *TacticalCommandCreatedEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, TacticalCommandCreated)
+	v.required("commandId", e.CommandID)
+	v.required("missionId", e.MissionID)
+	v.required("title", e.Title)
+	if !models.IsValidCategory(models.TacticalCommandCategory(e.Category)) {
+		v.fail("category", "must be a valid tactical command category, got %q", e.Category)
+	}
+	if !models.IsValidPriority(models.TacticalCommandPriority(e.Priority)) {
+		v.fail("priority", "must be a valid tactical command priority, got %q", e.Priority)
+	}
+	v.oneOf("commandSource", e.CommandSource, validCommandSources)
+	if len(e.Targets) == 0 {
+		v.fail("targets", "must have at least one target")
+	}
+	for i, t := range e.Targets {
+		field := fmt.Sprintf("targets[%d]", i)
+		v.oneOf(field+".targetType", t.TargetType, validTargetTypes)
+		v.required(field+".targetId", t.TargetID)
+	}
+	v.geoLocation("destination", e.Destination)
+	if a := e.AreaOfOperation; a != nil {
+		v.geoLocation("areaOfOperation.center", a.Center)
+		for i := range a.Coordinates {
+			v.geoLocation(fmt.Sprintf("areaOfOperation.coordinates[%d]", i), &a.Coordinates[i])
+		}
+		if a.Radius < 0 {
+			v.fail("areaOfOperation.radius", "must not be negative")
+		}
+	}
+	return v.err()
+}
+
+// Validate checks the decision and the resulting status
+func (e *TacticalCommandResponseEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, TacticalCommandResponse)
+	v.required("commandId", e.CommandID)
+	v.required("missionId", e.MissionID)
+	v.required("targetId", e.TargetID)
+	v.oneOf("targetType", e.TargetType, validTargetTypes)
+	v.oneOf("decision", e.Decision, validDecisions)
+	v.required("respondedBy", e.RespondedBy)
+	if e.NewStatus != "" {
+		v.commandStatus("newStatus", e.NewStatus)
+	}
+	return v.err()
+}
+
+// Validate checks required IDs and both statuses
+func (e *TacticalCommandStatusEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, TacticalCommandStatusChanged)
+	v.required("commandId", e.CommandID)
+	v.required("missionId", e.MissionID)
+	if e.OldStatus != "" {
+		v.commandStatus("oldStatus", e.OldStatus)
+	}
+	v.commandStatus("newStatus", e.NewStatus)
+	v.required("updatedBy", e.UpdatedBy)
+	return v.err()
+}
+
+// Validate checks required IDs and the sender role
+func (e *MissionChatMessageEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, MissionChatMessageEvent)
+	v.required("missionId", e.MissionID)
+	v.required("messageId", e.MessageID)
+	v.required("senderId", e.SenderID)
+	v.oneOf("senderRole", e.SenderRole, validChatSenderRoles)
+	v.required("content", e.Content)
+	return v.err()
+}
+
+// Validate checks the mission ID and typing users
+func (e *MissionTypingIndicatorEventData) Validate() error {
+	v := &validator{}
+	v.base(e.BaseEvent, MissionTypingIndicatorEvent)
+	v.required("missionId", e.MissionID)
+	for i, u := range e.TypingUsers {
+		v.required(fmt.Sprintf("typingUsers[%d].userId", i), u.UserID)
+	}
+	return v.err()
+}
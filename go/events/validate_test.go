@@ -0,0 +1,362 @@
+package events
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+var validateTime = time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+
+func validBase(eventType EventType) BaseEvent {
+	return BaseEvent{ID: "evt-1", Type: eventType, Source: "test", Timestamp: validateTime}
+}
+
+// validPayloads returns a fresh payload of every type in validate.go that passes Validate
+func validPayloads() map[EventType]Validator {
+	return map[EventType]Validator{
+		AssetUpdateEvent: &AssetUpdateEventData{
+			BaseEvent: validBase(AssetUpdateEvent), AssetID: "asset-1",
+			OldStatus: models.AssetStatusAvailable, NewStatus: models.AssetStatusDispatched,
+			Location: &LocationData{Latitude: 59.33, Longitude: 18.07},
+		},
+		AssetRecallEvent: &AssetRecallEventData{
+			BaseEvent: validBase(AssetRecallEvent), AssetID: "asset-1", Reason: string(models.RecallReasonLowBattery),
+			ReturnBaseID: "base-1", ReturnBy: validateTime.Add(time.Hour), RecalledBy: "op-1",
+		},
+		EmergencyNotification: &EmergencyNotificationEventData{
+			BaseEvent: validBase(EmergencyNotification), NotificationID: "n-1", Title: "Evacuate", Severity: "high",
+			RecipientCount: 12, Acknowledged: true, AcknowledgedBy: "op-1",
+		},
+		ChatMessageEvent: &ChatMessageEventData{BaseEvent: validBase(ChatMessageEvent), MessageID: "msg-1", Sender: "user"},
+		LocationUpdateEvent: &LocationUpdateEventData{
+			BaseEvent: validBase(LocationUpdateEvent), AssetID: "asset-1",
+			Location: &LocationData{Latitude: -33.87, Longitude: 151.21}, Speed: 12, Heading: 270,
+		},
+		VitalsUpdateEvent: &VitalsUpdateEventData{
+			BaseEvent: validBase(VitalsUpdateEvent), PersonnelID: "p-1", PulseRate: 72, OxygenLevel: 98,
+		},
+		SystemStatusEvent: &SystemStatusEventData{
+			BaseEvent: validBase(SystemStatusEvent), Status: "Emergency", PreviousStatus: "Normal", ChangedBy: "op-1",
+			ActiveAssets: 4, DispatchedAssets: 2,
+		},
+		VideoUploadEvent: &VideoUploadEventData{
+			BaseEvent: validBase(VideoUploadEvent), VideoID: "v-1", GCSPath: "gs://videos/v-1.mp4", Duration: 30, FileSize: 1024,
+		},
+		VideoProcessingEvent: &VideoProcessingEventData{
+			BaseEvent: validBase(VideoProcessingEvent), VideoID: "v-1", JobType: "ai_analysis", Status: "running", Progress: 40,
+		},
+		FrameExtractionEvent: &FrameExtractionEventData{
+			BaseEvent: validBase(FrameExtractionEvent), VideoID: "v-1", FrameID: "f-1", FrameNumber: 3, Timestamp: 1.5,
+		},
+		FrameUploadCompleteEvent: &FrameUploadCompleteEventData{
+			BaseEvent: validBase(FrameUploadCompleteEvent), VideoID: "v-1", FrameID: "f-1", FrameNumber: 3,
+		},
+		AIAnalysisEvent: &AIAnalysisEventData{
+			BaseEvent: validBase(AIAnalysisEvent), VideoID: "v-1", FrameID: "f-1", Confidence: 87,
+			Objects: []DetectedObject{{Type: "person", Confidence: 91}},
+			Events:  []DetectedEvent{{Type: "smoke", Confidence: 75, Severity: "medium", Location: &LocationData{Latitude: 1, Longitude: 2}}},
+		},
+		EventAnalysisEvent: &EventAnalysisEventData{
+			BaseEvent: validBase(EventAnalysisEvent), VideoID: "v-1", FrameID: "f-1", Confidence: 80,
+			Severity: "critical", Category: "safety",
+		},
+		SuggestionCreated: &SuggestionCreatedEventData{
+			BaseEvent: validBase(SuggestionCreated), SuggestionID: "s-1", EventID: "evt-0", MissionID: "m-1", Confidence: 64,
+		},
+		MissionCreated: &MissionCreatedEventData{
+			BaseEvent: validBase(MissionCreated), MissionID: "m-1", Title: "Wildfire north ridge", Priority: "high",
+			Status: string(models.MissionStatusActive), CreatedBy: "op-1",
+		},
+		AIMissionSuggestion: &AIMissionSuggestionEventData{
+			BaseEvent: validBase(AIMissionSuggestion), MissionID: "m-1", Confidence: 70,
+			TacticalCommands: []TacticalCommandSuggestion{{
+				Title: "Hold perimeter", TargetType: "team", Category: string(models.CategorySecurity), Priority: string(models.PriorityRoutine),
+			}},
+		},
+		TacticalCommandCreated: &TacticalCommandCreatedEventData{
+			BaseEvent: validBase(TacticalCommandCreated), CommandID: "cmd-1", MissionID: "m-1", Title: "Move to ridge",
+			Category: string(models.CategoryMovement), Priority: string(models.PriorityImmediate), CommandSource: "operator",
+			Targets:     []TacticalCommandTarget{{TargetType: "asset", TargetID: "asset-1"}},
+			Destination: &TacticalGeoLocation{Latitude: 59.3, Longitude: 18.1},
+			AreaOfOperation: &TacticalGeoArea{
+				Type: "circle", Center: &TacticalGeoLocation{Latitude: 59.3, Longitude: 18.1}, Radius: 500,
+				Coordinates: []TacticalGeoLocation{{Latitude: 59.2, Longitude: 18}},
+			},
+		},
+		TacticalCommandResponse: &TacticalCommandResponseEventData{
+			BaseEvent: validBase(TacticalCommandResponse), CommandID: "cmd-1", MissionID: "m-1", TargetID: "asset-1",
+			TargetType: "asset", Decision: "accepted", RespondedBy: "agent-1", NewStatus: string(models.TacticalCommandStatusAccepted),
+		},
+		TacticalCommandStatusChanged: &TacticalCommandStatusEventData{
+			BaseEvent: validBase(TacticalCommandStatusChanged), CommandID: "cmd-1", MissionID: "m-1",
+			OldStatus: string(models.TacticalCommandStatusPending), NewStatus: string(models.TacticalCommandStatusInProgress),
+			UpdatedBy: "agent-1",
+		},
+		MissionChatMessageEvent: &MissionChatMessageEventData{
+			BaseEvent: validBase(MissionChatMessageEvent), MissionID: "m-1", MessageID: "msg-1", SenderID: "op-1",
+			SenderRole: "operator", Content: "Hold position",
+		},
+		MissionTypingIndicatorEvent: &MissionTypingIndicatorEventData{
+			BaseEvent: validBase(MissionTypingIndicatorEvent), MissionID: "m-1",
+			TypingUsers: []MissionTypingUser{{UserID: "op-1"}},
+		},
+	}
+}
+
+// valid returns the valid sample of a payload type
+func valid[P Validator](eventType EventType) P {
+	return validPayloads()[eventType].(P)
+}
+
+// edit applies a change to a payload and returns it
+func edit[P Validator](p P, change func(P)) P {
+	change(p)
+	return p
+}
+
+func invalidFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("%v is not a ValidationErrors", err)
+	}
+	var fields []string
+	for _, fe := range verrs {
+		fields = append(fields, fe.Field)
+	}
+	return fields
+}
+
+func TestValidPayloads(t *testing.T) {
+	for eventType, payload := range validPayloads() {
+		want, err := NewPayload(eventType)
+		if err != nil {
+			t.Fatalf("%s: %v", eventType, err)
+		}
+		if reflect.TypeOf(payload) != reflect.TypeOf(want) {
+			t.Errorf("%s: sample is %T, want %T", eventType, payload, want)
+		}
+		if err := Validate(payload); err != nil {
+			t.Errorf("%s: %v", eventType, err)
+		}
+	}
+	if err := Validate(struct{}{}); err != nil {
+		t.Errorf("Validate of a payload without rules: %v", err)
+	}
+}
+
+// TestValidateRules has one failing case per rule in validate.go
+func TestValidateRules(t *testing.T) {
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	for _, tt := range []struct {
+		name    string
+		payload Validator
+		want    []string
+	}{
+		// Base event
+		{"id missing", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.ID = " " }), []string{"id"}},
+		{"type mismatch", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.Type = MissionCreated }), []string{"type"}},
+		{"source missing", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.Source = "" }), []string{"source"}},
+		{"causation without correlation", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.CausationID = "evt-0" }), []string{"correlationId"}},
+		{"causation with correlation", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) {
+			e.CausationID, e.CorrelationID, e.TraceParent, e.TraceState = "evt-0", "evt-0", traceParent, "vendor=1"
+		}), nil},
+		{"bad traceparent", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.TraceParent = "00-abc" }), []string{"traceparent"}},
+		{"tracestate without traceparent", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.TraceState = "vendor=1" }), []string{"tracestate"}},
+		{"negative sequence", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.AggregateID, e.Sequence = "cmd-1", -1 }), []string{"sequence"}},
+		{"sequence without aggregate", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.Sequence = 3 }), []string{"aggregateId"}},
+		{"bad tenant", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.TenantID = "Acme Corp" }), []string{"tenantId"}},
+		{"empty encryption", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.Encryption = &FieldEncryption{} }),
+			[]string{"encryption.keyId", "encryption.dataKey", "encryption.fields", "encryption.ciphertext"}},
+
+		// Asset update
+		{"asset update without asset", edit(valid[*AssetUpdateEventData](AssetUpdateEvent), func(e *AssetUpdateEventData) { e.AssetID = "" }), []string{"assetId"}},
+		{"asset update old status", edit(valid[*AssetUpdateEventData](AssetUpdateEvent), func(e *AssetUpdateEventData) { e.OldStatus = "lost" }), []string{"oldStatus"}},
+		{"asset update without old status", edit(valid[*AssetUpdateEventData](AssetUpdateEvent), func(e *AssetUpdateEventData) { e.OldStatus = "" }), nil},
+		{"asset update new status", edit(valid[*AssetUpdateEventData](AssetUpdateEvent), func(e *AssetUpdateEventData) { e.NewStatus = "" }), []string{"newStatus"}},
+		{"asset update latitude", edit(valid[*AssetUpdateEventData](AssetUpdateEvent), func(e *AssetUpdateEventData) { e.Location.Latitude = 200 }), []string{"location.latitude"}},
+
+		// Asset recall
+		{"recall without asset", edit(valid[*AssetRecallEventData](AssetRecallEvent), func(e *AssetRecallEventData) { e.AssetID = "" }), []string{"assetId"}},
+		{"recall reason", edit(valid[*AssetRecallEventData](AssetRecallEvent), func(e *AssetRecallEventData) { e.Reason = "bored" }), []string{"reason"}},
+		{"recall operator order without notes", edit(valid[*AssetRecallEventData](AssetRecallEvent), func(e *AssetRecallEventData) { e.Reason = string(models.RecallReasonOperatorOrder) }), []string{"notes"}},
+		{"recall without base", edit(valid[*AssetRecallEventData](AssetRecallEvent), func(e *AssetRecallEventData) { e.ReturnBaseID = "" }), []string{"returnBaseId"}},
+		{"recall without deadline", edit(valid[*AssetRecallEventData](AssetRecallEvent), func(e *AssetRecallEventData) { e.ReturnBy = time.Time{} }), []string{"returnBy"}},
+		{"recall deadline in the past", edit(valid[*AssetRecallEventData](AssetRecallEvent), func(e *AssetRecallEventData) { e.ReturnBy = validateTime.Add(-time.Minute) }), []string{"returnBy"}},
+		{"recall without operator", edit(valid[*AssetRecallEventData](AssetRecallEvent), func(e *AssetRecallEventData) { e.RecalledBy = "" }), []string{"recalledBy"}},
+		{"recall longitude", edit(valid[*AssetRecallEventData](AssetRecallEvent), func(e *AssetRecallEventData) { e.Location = &LocationData{Longitude: -181} }), []string{"location.longitude"}},
+
+		// Emergency notification
+		{"notification without id", edit(valid[*EmergencyNotificationEventData](EmergencyNotification), func(e *EmergencyNotificationEventData) { e.NotificationID = "" }), []string{"notificationId"}},
+		{"notification without title", edit(valid[*EmergencyNotificationEventData](EmergencyNotification), func(e *EmergencyNotificationEventData) { e.Title = "" }), []string{"title"}},
+		{"notification severity", edit(valid[*EmergencyNotificationEventData](EmergencyNotification), func(e *EmergencyNotificationEventData) { e.Severity = "urgent" }), []string{"severity"}},
+		{"notification recipients", edit(valid[*EmergencyNotificationEventData](EmergencyNotification), func(e *EmergencyNotificationEventData) { e.RecipientCount = -1 }), []string{"recipientCount"}},
+		{"notification coordinates", edit(valid[*EmergencyNotificationEventData](EmergencyNotification), func(e *EmergencyNotificationEventData) { e.Coordinates = &LocationData{Latitude: -91} }), []string{"coordinates.latitude"}},
+		{"acknowledged without operator", edit(valid[*EmergencyNotificationEventData](EmergencyNotification), func(e *EmergencyNotificationEventData) { e.AcknowledgedBy = "" }), []string{"acknowledgedBy"}},
+
+		// Chat message
+		{"chat without message", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.MessageID = "" }), []string{"messageId"}},
+		{"chat sender", edit(valid[*ChatMessageEventData](ChatMessageEvent), func(e *ChatMessageEventData) { e.Sender = "bot" }), []string{"sender"}},
+
+		// Location update
+		{"location update without asset", edit(valid[*LocationUpdateEventData](LocationUpdateEvent), func(e *LocationUpdateEventData) { e.AssetID = "" }), []string{"assetId"}},
+		{"location update without location", edit(valid[*LocationUpdateEventData](LocationUpdateEvent), func(e *LocationUpdateEventData) { e.Location = nil }), []string{"location"}},
+		{"location update latitude", edit(valid[*LocationUpdateEventData](LocationUpdateEvent), func(e *LocationUpdateEventData) { e.Location.Latitude = 200 }), []string{"location.latitude"}},
+		{"location update speed", edit(valid[*LocationUpdateEventData](LocationUpdateEvent), func(e *LocationUpdateEventData) { e.Speed = -1 }), []string{"speed"}},
+		{"location update heading", edit(valid[*LocationUpdateEventData](LocationUpdateEvent), func(e *LocationUpdateEventData) { e.Heading = 361 }), []string{"heading"}},
+
+		// Vitals update
+		{"vitals without personnel", edit(valid[*VitalsUpdateEventData](VitalsUpdateEvent), func(e *VitalsUpdateEventData) { e.PersonnelID = "" }), []string{"personnelId"}},
+		{"vitals zero pulse", edit(valid[*VitalsUpdateEventData](VitalsUpdateEvent), func(e *VitalsUpdateEventData) { e.PulseRate = 0 }), []string{"pulseRate"}},
+		{"vitals zero pulse on alert", edit(valid[*VitalsUpdateEventData](VitalsUpdateEvent), func(e *VitalsUpdateEventData) { e.PulseRate, e.IsAlert, e.AlertReason = 0, true, "no pulse" }), nil},
+		{"vitals encrypted pulse", edit(valid[*VitalsUpdateEventData](VitalsUpdateEvent), func(e *VitalsUpdateEventData) {
+			e.PulseRate, e.OxygenLevel = 0, 0
+			e.Encryption = &FieldEncryption{KeyID: "k1", DataKey: []byte{1}, Fields: []string{"pulseRate", "oxygenLevel"}, Ciphertext: []byte{2}}
+		}), nil},
+		{"vitals pulse too high", edit(valid[*VitalsUpdateEventData](VitalsUpdateEvent), func(e *VitalsUpdateEventData) { e.PulseRate = 301 }), []string{"pulseRate"}},
+		{"vitals oxygen", edit(valid[*VitalsUpdateEventData](VitalsUpdateEvent), func(e *VitalsUpdateEventData) { e.OxygenLevel = 101 }), []string{"oxygenLevel"}},
+		{"vitals alert without reason", edit(valid[*VitalsUpdateEventData](VitalsUpdateEvent), func(e *VitalsUpdateEventData) { e.IsAlert = true }), []string{"alertReason"}},
+		{"vitals alert with encrypted reason", edit(valid[*VitalsUpdateEventData](VitalsUpdateEvent), func(e *VitalsUpdateEventData) {
+			e.IsAlert = true
+			e.Encryption = &FieldEncryption{KeyID: "k1", DataKey: []byte{1}, Fields: []string{"alertReason"}, Ciphertext: []byte{2}}
+		}), nil},
+
+		// System status
+		{"system status", edit(valid[*SystemStatusEventData](SystemStatusEvent), func(e *SystemStatusEventData) { e.Status = "normal" }), []string{"status"}},
+		{"system previous status", edit(valid[*SystemStatusEventData](SystemStatusEvent), func(e *SystemStatusEventData) { e.PreviousStatus = "Down" }), []string{"previousStatus"}},
+		{"system status without operator", edit(valid[*SystemStatusEventData](SystemStatusEvent), func(e *SystemStatusEventData) { e.ChangedBy = "" }), []string{"changedBy"}},
+		{"system active assets", edit(valid[*SystemStatusEventData](SystemStatusEvent), func(e *SystemStatusEventData) { e.ActiveAssets = -1 }), []string{"activeAssets"}},
+		{"system dispatched assets", edit(valid[*SystemStatusEventData](SystemStatusEvent), func(e *SystemStatusEventData) { e.DispatchedAssets = -1 }), []string{"dispatchedAssets"}},
+
+		// Video upload
+		{"upload without video", edit(valid[*VideoUploadEventData](VideoUploadEvent), func(e *VideoUploadEventData) { e.VideoID = "" }), []string{"videoId"}},
+		{"upload without path", edit(valid[*VideoUploadEventData](VideoUploadEvent), func(e *VideoUploadEventData) { e.GCSPath = "" }), []string{"gcsPath"}},
+		{"upload duration", edit(valid[*VideoUploadEventData](VideoUploadEvent), func(e *VideoUploadEventData) { e.Duration = -1 }), []string{"duration"}},
+		{"upload size", edit(valid[*VideoUploadEventData](VideoUploadEvent), func(e *VideoUploadEventData) { e.FileSize = -1 }), []string{"fileSize"}},
+		{"upload location", edit(valid[*VideoUploadEventData](VideoUploadEvent), func(e *VideoUploadEventData) { e.Location = &LocationData{Longitude: 181} }), []string{"location.longitude"}},
+
+		// Video processing
+		{"processing without video", edit(valid[*VideoProcessingEventData](VideoProcessingEvent), func(e *VideoProcessingEventData) { e.VideoID = "" }), []string{"videoId"}},
+		{"processing job type", edit(valid[*VideoProcessingEventData](VideoProcessingEvent), func(e *VideoProcessingEventData) { e.JobType = "upload" }), []string{"jobType"}},
+		{"processing status", edit(valid[*VideoProcessingEventData](VideoProcessingEvent), func(e *VideoProcessingEventData) { e.Status = "done" }), []string{"status"}},
+		{"processing progress", edit(valid[*VideoProcessingEventData](VideoProcessingEvent), func(e *VideoProcessingEventData) { e.Progress = 100.5 }), []string{"progress"}},
+		{"processing failed without message", edit(valid[*VideoProcessingEventData](VideoProcessingEvent), func(e *VideoProcessingEventData) { e.Status = "failed" }), []string{"errorMsg"}},
+
+		// Frame extraction
+		{"extraction without video", edit(valid[*FrameExtractionEventData](FrameExtractionEvent), func(e *FrameExtractionEventData) { e.VideoID = "" }), []string{"videoId"}},
+		{"extraction without frame", edit(valid[*FrameExtractionEventData](FrameExtractionEvent), func(e *FrameExtractionEventData) { e.FrameID = "" }), []string{"frameId"}},
+		{"extraction frame number", edit(valid[*FrameExtractionEventData](FrameExtractionEvent), func(e *FrameExtractionEventData) { e.FrameNumber = -1 }), []string{"frameNumber"}},
+		{"extraction timestamp", edit(valid[*FrameExtractionEventData](FrameExtractionEvent), func(e *FrameExtractionEventData) { e.Timestamp = -0.5 }), []string{"timestamp"}},
+		{"extraction location", edit(valid[*FrameExtractionEventData](FrameExtractionEvent), func(e *FrameExtractionEventData) { e.Location = &LocationData{Latitude: 90.1} }), []string{"location.latitude"}},
+
+		// Frame upload complete
+		{"frame upload without video", edit(valid[*FrameUploadCompleteEventData](FrameUploadCompleteEvent), func(e *FrameUploadCompleteEventData) { e.VideoID = "" }), []string{"videoId"}},
+		{"frame upload without frame", edit(valid[*FrameUploadCompleteEventData](FrameUploadCompleteEvent), func(e *FrameUploadCompleteEventData) { e.FrameID = "" }), []string{"frameId"}},
+		{"frame upload frame number", edit(valid[*FrameUploadCompleteEventData](FrameUploadCompleteEvent), func(e *FrameUploadCompleteEventData) { e.FrameNumber = -1 }), []string{"frameNumber"}},
+		{"frame upload retries", edit(valid[*FrameUploadCompleteEventData](FrameUploadCompleteEvent), func(e *FrameUploadCompleteEventData) { e.RetryCount = -1 }), []string{"retryCount"}},
+		{"frame upload location", edit(valid[*FrameUploadCompleteEventData](FrameUploadCompleteEvent), func(e *FrameUploadCompleteEventData) { e.Location = &LocationData{Longitude: -180.5} }), []string{"location.longitude"}},
+
+		// AI analysis
+		{"analysis without video", edit(valid[*AIAnalysisEventData](AIAnalysisEvent), func(e *AIAnalysisEventData) { e.VideoID = "" }), []string{"videoId"}},
+		{"analysis without frame", edit(valid[*AIAnalysisEventData](AIAnalysisEvent), func(e *AIAnalysisEventData) { e.FrameID = "" }), []string{"frameId"}},
+		{"analysis confidence", edit(valid[*AIAnalysisEventData](AIAnalysisEvent), func(e *AIAnalysisEventData) { e.Confidence = -1 }), []string{"confidence"}},
+		{"analysis object confidence", edit(valid[*AIAnalysisEventData](AIAnalysisEvent), func(e *AIAnalysisEventData) { e.Objects[0].Confidence = 120 }), []string{"objects[0].confidence"}},
+		{"analysis event confidence", edit(valid[*AIAnalysisEventData](AIAnalysisEvent), func(e *AIAnalysisEventData) { e.Events[0].Confidence = 120 }), []string{"events[0].confidence"}},
+		{"analysis event severity", edit(valid[*AIAnalysisEventData](AIAnalysisEvent), func(e *AIAnalysisEventData) { e.Events[0].Severity = "severe" }), []string{"events[0].severity"}},
+		{"analysis event location", edit(valid[*AIAnalysisEventData](AIAnalysisEvent), func(e *AIAnalysisEventData) { e.Events[0].Location.Latitude = 95 }), []string{"events[0].location.latitude"}},
+
+		// Event analysis
+		{"event analysis without video", edit(valid[*EventAnalysisEventData](EventAnalysisEvent), func(e *EventAnalysisEventData) { e.VideoID = "" }), []string{"videoId"}},
+		{"event analysis without frame", edit(valid[*EventAnalysisEventData](EventAnalysisEvent), func(e *EventAnalysisEventData) { e.FrameID = "" }), []string{"frameId"}},
+		{"event analysis confidence", edit(valid[*EventAnalysisEventData](EventAnalysisEvent), func(e *EventAnalysisEventData) { e.Confidence = 101 }), []string{"confidence"}},
+		{"event analysis severity", edit(valid[*EventAnalysisEventData](EventAnalysisEvent), func(e *EventAnalysisEventData) { e.Severity = "" }), []string{"severity"}},
+		{"event analysis category", edit(valid[*EventAnalysisEventData](EventAnalysisEvent), func(e *EventAnalysisEventData) { e.Category = "fire" }), []string{"category"}},
+		{"event analysis location", edit(valid[*EventAnalysisEventData](EventAnalysisEvent), func(e *EventAnalysisEventData) { e.Location = &LocationData{Latitude: -90.5} }), []string{"location.latitude"}},
+
+		// Suggestion created
+		{"suggestion without id", edit(valid[*SuggestionCreatedEventData](SuggestionCreated), func(e *SuggestionCreatedEventData) { e.SuggestionID = "" }), []string{"suggestionId"}},
+		{"suggestion without event", edit(valid[*SuggestionCreatedEventData](SuggestionCreated), func(e *SuggestionCreatedEventData) { e.EventID = "" }), []string{"eventId"}},
+		{"suggestion without mission", edit(valid[*SuggestionCreatedEventData](SuggestionCreated), func(e *SuggestionCreatedEventData) { e.MissionID = "" }), []string{"missionId"}},
+		{"suggestion confidence", edit(valid[*SuggestionCreatedEventData](SuggestionCreated), func(e *SuggestionCreatedEventData) { e.Confidence = 100.1 }), []string{"confidence"}},
+
+		// Mission created
+		{"mission without id", edit(valid[*MissionCreatedEventData](MissionCreated), func(e *MissionCreatedEventData) { e.MissionID = "" }), []string{"missionId"}},
+		{"mission without title", edit(valid[*MissionCreatedEventData](MissionCreated), func(e *MissionCreatedEventData) { e.Title = "" }), []string{"title"}},
+		{"mission priority", edit(valid[*MissionCreatedEventData](MissionCreated), func(e *MissionCreatedEventData) { e.Priority = "flash" }), []string{"priority"}},
+		{"mission status", edit(valid[*MissionCreatedEventData](MissionCreated), func(e *MissionCreatedEventData) { e.Status = "draft" }), []string{"status"}},
+		{"mission location", edit(valid[*MissionCreatedEventData](MissionCreated), func(e *MissionCreatedEventData) { e.Location = &LocationData{Longitude: 190} }), []string{"location.longitude"}},
+		{"mission without creator", edit(valid[*MissionCreatedEventData](MissionCreated), func(e *MissionCreatedEventData) { e.CreatedBy = "" }), []string{"createdBy"}},
+
+		// AI mission suggestion
+		{"mission suggestion without mission", edit(valid[*AIMissionSuggestionEventData](AIMissionSuggestion), func(e *AIMissionSuggestionEventData) { e.MissionID = "" }), []string{"missionId"}},
+		{"mission suggestion confidence", edit(valid[*AIMissionSuggestionEventData](AIMissionSuggestion), func(e *AIMissionSuggestionEventData) { e.Confidence = -0.1 }), []string{"confidence"}},
+		{"suggested command without title", edit(valid[*AIMissionSuggestionEventData](AIMissionSuggestion), func(e *AIMissionSuggestionEventData) { e.TacticalCommands[0].Title = "" }), []string{"tacticalCommands[0].title"}},
+		{"suggested command target type", edit(valid[*AIMissionSuggestionEventData](AIMissionSuggestion), func(e *AIMissionSuggestionEventData) { e.TacticalCommands[0].TargetType = "person" }), []string{"tacticalCommands[0].targetType"}},
+		{"suggested command category", edit(valid[*AIMissionSuggestionEventData](AIMissionSuggestion), func(e *AIMissionSuggestionEventData) { e.TacticalCommands[0].Category = "attack" }), []string{"tacticalCommands[0].category"}},
+		{"suggested command priority", edit(valid[*AIMissionSuggestionEventData](AIMissionSuggestion), func(e *AIMissionSuggestionEventData) { e.TacticalCommands[0].Priority = "high" }), []string{"tacticalCommands[0].priority"}},
+
+		// Tactical command created
+		{"command without id", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.CommandID = "" }), []string{"commandId"}},
+		{"command without mission", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.MissionID = "" }), []string{"missionId"}},
+		{"command without title", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.Title = "" }), []string{"title"}},
+		{"command category", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.Category = "attack" }), []string{"category"}},
+		{"command priority", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.Priority = "high" }), []string{"priority"}},
+		{"command source", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.CommandSource = "system" }), []string{"commandSource"}},
+		{"command without targets", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.Targets = nil }), []string{"targets"}},
+		{"command target type", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.Targets[0].TargetType = "person" }), []string{"targets[0].targetType"}},
+		{"command target without id", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.Targets[0].TargetID = "" }), []string{"targets[0].targetId"}},
+		{"command destination", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.Destination.Latitude = 200 }), []string{"destination.lat"}},
+		{"command area center", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.AreaOfOperation.Center.Longitude = 200 }), []string{"areaOfOperation.center.lng"}},
+		{"command area coordinates", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.AreaOfOperation.Coordinates[0].Latitude = -95 }), []string{"areaOfOperation.coordinates[0].lat"}},
+		{"command area radius", edit(valid[*TacticalCommandCreatedEventData](TacticalCommandCreated), func(e *TacticalCommandCreatedEventData) { e.AreaOfOperation.Radius = -1 }), []string{"areaOfOperation.radius"}},
+
+		// Tactical command response
+		{"response without command", edit(valid[*TacticalCommandResponseEventData](TacticalCommandResponse), func(e *TacticalCommandResponseEventData) { e.CommandID = "" }), []string{"commandId"}},
+		{"response without mission", edit(valid[*TacticalCommandResponseEventData](TacticalCommandResponse), func(e *TacticalCommandResponseEventData) { e.MissionID = "" }), []string{"missionId"}},
+		{"response without target", edit(valid[*TacticalCommandResponseEventData](TacticalCommandResponse), func(e *TacticalCommandResponseEventData) { e.TargetID = "" }), []string{"targetId"}},
+		{"response target type", edit(valid[*TacticalCommandResponseEventData](TacticalCommandResponse), func(e *TacticalCommandResponseEventData) { e.TargetType = "" }), []string{"targetType"}},
+		{"response decision", edit(valid[*TacticalCommandResponseEventData](TacticalCommandResponse), func(e *TacticalCommandResponseEventData) { e.Decision = "maybe" }), []string{"decision"}},
+		{"response without responder", edit(valid[*TacticalCommandResponseEventData](TacticalCommandResponse), func(e *TacticalCommandResponseEventData) { e.RespondedBy = "" }), []string{"respondedBy"}},
+		{"response status", edit(valid[*TacticalCommandResponseEventData](TacticalCommandResponse), func(e *TacticalCommandResponseEventData) { e.NewStatus = "done" }), []string{"newStatus"}},
+
+		// Tactical command status
+		{"status without command", edit(valid[*TacticalCommandStatusEventData](TacticalCommandStatusChanged), func(e *TacticalCommandStatusEventData) { e.CommandID = "" }), []string{"commandId"}},
+		{"status without mission", edit(valid[*TacticalCommandStatusEventData](TacticalCommandStatusChanged), func(e *TacticalCommandStatusEventData) { e.MissionID = "" }), []string{"missionId"}},
+		{"status old status", edit(valid[*TacticalCommandStatusEventData](TacticalCommandStatusChanged), func(e *TacticalCommandStatusEventData) { e.OldStatus = "done" }), []string{"oldStatus"}},
+		{"status new status", edit(valid[*TacticalCommandStatusEventData](TacticalCommandStatusChanged), func(e *TacticalCommandStatusEventData) { e.NewStatus = "" }), []string{"newStatus"}},
+		{"status without updater", edit(valid[*TacticalCommandStatusEventData](TacticalCommandStatusChanged), func(e *TacticalCommandStatusEventData) { e.UpdatedBy = "" }), []string{"updatedBy"}},
+
+		// Mission chat
+		{"mission chat without mission", edit(valid[*MissionChatMessageEventData](MissionChatMessageEvent), func(e *MissionChatMessageEventData) { e.MissionID = "" }), []string{"missionId"}},
+		{"mission chat without message", edit(valid[*MissionChatMessageEventData](MissionChatMessageEvent), func(e *MissionChatMessageEventData) { e.MessageID = "" }), []string{"messageId"}},
+		{"mission chat without sender", edit(valid[*MissionChatMessageEventData](MissionChatMessageEvent), func(e *MissionChatMessageEventData) { e.SenderID = "" }), []string{"senderId"}},
+		{"mission chat sender role", edit(valid[*MissionChatMessageEventData](MissionChatMessageEvent), func(e *MissionChatMessageEventData) { e.SenderRole = "admin" }), []string{"senderRole"}},
+		{"mission chat without content", edit(valid[*MissionChatMessageEventData](MissionChatMessageEvent), func(e *MissionChatMessageEventData) { e.Content = "\n" }), []string{"content"}},
+
+		// Mission typing indicator
+		{"typing without mission", edit(valid[*MissionTypingIndicatorEventData](MissionTypingIndicatorEvent), func(e *MissionTypingIndicatorEventData) { e.MissionID = "" }), []string{"missionId"}},
+		{"typing user without id", edit(valid[*MissionTypingIndicatorEventData](MissionTypingIndicatorEvent), func(e *MissionTypingIndicatorEventData) { e.TypingUsers[0].UserID = "" }), []string{"typingUsers[0].userId"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := invalidFields(t, tt.payload.Validate()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("invalid fields %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationErrorsMessage(t *testing.T) {
+	e := valid[*VitalsUpdateEventData](VitalsUpdateEvent)
+	e.PulseRate, e.OxygenLevel = 0, 140
+	want := "invalid fields: pulseRate: must be positive unless isAlert is set, got 0; oxygenLevel: must be between 0 and 100, got 140"
+	if err := e.Validate(); err == nil || err.Error() != want {
+		t.Errorf("error %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,427 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WebSocket protocol of the communication hub (GET /ws).
+//
+// Every frame is a JSON object with a "type". After connecting, a client opens one or
+// more subscriptions and the hub pushes matching events as "event" frames:
+//
+//	client → {"type":"subscribe","requestId":"r1","subscriptionId":"s1","filter":{"missionIds":["m1"]}}
+//	hub    → {"type":"ack","requestId":"r1","subscriptionId":"s1"}
+//	hub    → {"type":"event","subscriptionId":"s1","offset":1042,"event":"mission_created","data":{...},...}
+//	client → {"type":"ack","subscriptionId":"s1","offset":1042}
+//
+// Offsets are assigned by the hub, increase with every event it pushes and are never
+// reused. Clients choose subscription IDs, so after a reconnect they subscribe again with
+// the same ID and send "resume" with the last offset they processed; the hub replays the
+// missed events or answers with an offset_expired error, in which case the client
+// reloads state over REST.
+//
+// Either side may send "ping"; the other answers with a "pong" echoing its timestamp.
+// A connection that has not answered a ping within WebSocketPongTimeout is closed.
+
+// ErrUnknownFrameType is returned when a WebSocket frame has a missing or unknown type
+var ErrUnknownFrameType = errors.New("events: unknown WebSocket frame type")
+
+const (
+	// WebSocketPingInterval is how often an idle side of a connection sends a ping
+	WebSocketPingInterval = 30 * time.Second
+	// WebSocketPongTimeout is how long a side waits for a pong before closing the connection
+	WebSocketPongTimeout = 10 * time.Second
+)
+
+// WebSocketFrameType identifies a frame of the WebSocket protocol
+type WebSocketFrameType string
+
+const (
+	FrameSubscribe   WebSocketFrameType = "subscribe"
+	FrameUnsubscribe WebSocketFrameType = "unsubscribe"
+	FrameResume      WebSocketFrameType = "resume"
+	FrameAck         WebSocketFrameType = "ack"
+	FramePing        WebSocketFrameType = "ping"
+	FramePong        WebSocketFrameType = "pong"
+	FrameError       WebSocketFrameType = "error"
+	FrameEvent       WebSocketFrameType = "event"
+)
+
+// ValidFrameTypes returns all WebSocket frame types
+func ValidFrameTypes() []WebSocketFrameType {
+	return []WebSocketFrameType{
+		FrameSubscribe, FrameUnsubscribe, FrameResume, FrameAck, FramePing, FramePong, FrameError, FrameEvent,
+	}
+}
+
+// FromClient reports whether clients may send frames of this type
+func (t WebSocketFrameType) FromClient() bool {
+	return t != FrameError && t != FrameEvent
+}
+
+// FromServer reports whether the hub may send frames of this type
+func (t WebSocketFrameType) FromServer() bool {
+	return t != FrameSubscribe && t != FrameUnsubscribe && t != FrameResume
+}
+
+// WebSocketErrorCode classifies an error frame
+type WebSocketErrorCode string
+
+const (
+	// ErrorCodeInvalidFrame means the frame could not be decoded or failed validation
+	ErrorCodeInvalidFrame WebSocketErrorCode = "invalid_frame"
+	// ErrorCodeUnauthorized means the token does not allow the request
+	ErrorCodeUnauthorized WebSocketErrorCode = "unauthorized"
+	// ErrorCodeUnknownSubscription means the subscription ID is not open on this connection
+	ErrorCodeUnknownSubscription WebSocketErrorCode = "unknown_subscription"
+	// ErrorCodeOffsetExpired means the hub no longer holds the events after the resume offset
+	ErrorCodeOffsetExpired WebSocketErrorCode = "offset_expired"
+	// ErrorCodeRateLimited means the client sends frames too fast
+	ErrorCodeRateLimited WebSocketErrorCode = "rate_limited"
+	// ErrorCodeInternal means the hub failed to handle the frame
+	ErrorCodeInternal WebSocketErrorCode = "internal"
+)
+
+// ValidErrorCodes returns all WebSocket error codes
+func ValidErrorCodes() []WebSocketErrorCode {
+	return []WebSocketErrorCode{
+		ErrorCodeInvalidFrame,
+		ErrorCodeUnauthorized,
+		ErrorCodeUnknownSubscription,
+		ErrorCodeOffsetExpired,
+		ErrorCodeRateLimited,
+		ErrorCodeInternal,
+	}
+}
+
+// IsValidErrorCode checks if an error code is defined by the protocol
+func IsValidErrorCode(code WebSocketErrorCode) bool {
+	for _, c := range ValidErrorCodes() {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// WebSocketFrame is implemented by every frame of the protocol
+type WebSocketFrame interface {
+	Validator
+	FrameType() WebSocketFrameType
+}
+
+// SubscriptionFilter selects the events of a subscription. Every dimension that is set
+// must match; within a dimension any listed value matches.
+type SubscriptionFilter struct {
+	MissionIDs []string    `json:"missionIds,omitempty"`
+	AreaIDs    []string    `json:"areaIds,omitempty"`
+	EventTypes []EventType `json:"eventTypes,omitempty"`
+}
+
+// Matches reports whether an event with the given type, mission and areas passes the filter
+func (f SubscriptionFilter) Matches(eventType EventType, missionID string, areaIDs ...string) bool {
+	if len(f.EventTypes) > 0 && !contains(f.EventTypes, eventType) {
+		return false
+	}
+	if len(f.MissionIDs) > 0 && !contains(f.MissionIDs, missionID) {
+		return false
+	}
+	if len(f.AreaIDs) > 0 {
+		for _, id := range areaIDs {
+			if contains(f.AreaIDs, id) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func contains[T comparable](values []T, v T) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// SubscribeFrame opens a subscription, or replaces the filter of an open one
+type SubscribeFrame struct {
+	Type           WebSocketFrameType `json:"type"`
+	RequestID      string             `json:"requestId"`
+	SubscriptionID string             `json:"subscriptionId"`
+	Filter         SubscriptionFilter `json:"filter"`
+}
+
+// UnsubscribeFrame closes a subscription
+type UnsubscribeFrame struct {
+	Type           WebSocketFrameType `json:"type"`
+	RequestID      string             `json:"requestId"`
+	SubscriptionID string             `json:"subscriptionId"`
+}
+
+// ResumeFrame asks the hub to replay the events of a subscription after Offset
+type ResumeFrame struct {
+	Type           WebSocketFrameType `json:"type"`
+	RequestID      string             `json:"requestId"`
+	SubscriptionID string             `json:"subscriptionId"`
+	Offset         int64              `json:"offset"`
+}
+
+// AckFrame is sent by the hub to confirm a request, identified by RequestID, and by
+// clients to confirm the events of a subscription up to Offset
+type AckFrame struct {
+	Type           WebSocketFrameType `json:"type"`
+	RequestID      string             `json:"requestId,omitempty"`
+	SubscriptionID string             `json:"subscriptionId,omitempty"`
+	Offset         int64              `json:"offset,omitempty"`
+}
+
+// PingFrame checks that the other side is alive
+type PingFrame struct {
+	Type      WebSocketFrameType `json:"type"`
+	Timestamp time.Time          `json:"timestamp"`
+}
+
+// PongFrame answers a ping with its timestamp, so the sender can measure round-trip time
+type PongFrame struct {
+	Type      WebSocketFrameType `json:"type"`
+	Timestamp time.Time          `json:"timestamp"`
+}
+
+// ErrorFrame reports a failed request, or a connection-level problem when RequestID is empty
+type ErrorFrame struct {
+	Type           WebSocketFrameType `json:"type"`
+	RequestID      string             `json:"requestId,omitempty"`
+	SubscriptionID string             `json:"subscriptionId,omitempty"`
+	Code           WebSocketErrorCode `json:"code"`
+	Message        string             `json:"message"`
+}
+
+// NewErrorFrame returns an error frame answering the request
+func NewErrorFrame(requestID string, code WebSocketErrorCode, message string) *ErrorFrame {
+	return &ErrorFrame{Type: FrameError, RequestID: requestID, Code: code, Message: message}
+}
+
+func (*SubscribeFrame) FrameType() WebSocketFrameType   { return FrameSubscribe }
+func (*UnsubscribeFrame) FrameType() WebSocketFrameType { return FrameUnsubscribe }
+func (*ResumeFrame) FrameType() WebSocketFrameType      { return FrameResume }
+func (*AckFrame) FrameType() WebSocketFrameType         { return FrameAck }
+func (*PingFrame) FrameType() WebSocketFrameType        { return FramePing }
+func (*PongFrame) FrameType() WebSocketFrameType        { return FramePong }
+func (*ErrorFrame) FrameType() WebSocketFrameType       { return FrameError }
+func (*WebSocketMessage) FrameType() WebSocketFrameType { return FrameEvent }
+
+// newFrame returns an empty frame of the given type
+func newFrame(t WebSocketFrameType) (WebSocketFrame, error) {
+	switch t {
+	case FrameSubscribe:
+		return &SubscribeFrame{}, nil
+	case FrameUnsubscribe:
+		return &UnsubscribeFrame{}, nil
+	case FrameResume:
+		return &ResumeFrame{}, nil
+	case FrameAck:
+		return &AckFrame{}, nil
+	case FramePing:
+		return &PingFrame{}, nil
+	case FramePong:
+		return &PongFrame{}, nil
+	case FrameError:
+		return &ErrorFrame{}, nil
+	case FrameEvent:
+		return &WebSocketMessage{}, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownFrameType, t)
+}
+
+// setFrameType stamps the frame's own type on its type field
+func setFrameType(f WebSocketFrame) {
+	switch f := f.(type) {
+	case *SubscribeFrame:
+		f.Type = FrameSubscribe
+	case *UnsubscribeFrame:
+		f.Type = FrameUnsubscribe
+	case *ResumeFrame:
+		f.Type = FrameResume
+	case *AckFrame:
+		f.Type = FrameAck
+	case *PingFrame:
+		f.Type = FramePing
+	case *PongFrame:
+		f.Type = FramePong
+	case *ErrorFrame:
+		f.Type = FrameError
+	case *WebSocketMessage:
+		f.Type = string(FrameEvent)
+	}
+}
+
+// EncodeFrame sets the frame's type field, validates the frame and marshals it
+func EncodeFrame(f WebSocketFrame) ([]byte, error) {
+	setFrameType(f)
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(f)
+}
+
+// DecodeFrame reads a frame and validates it. Unknown fields are ignored, so either side
+// can add optional fields without breaking older peers.
+func DecodeFrame(data []byte) (WebSocketFrame, error) {
+	var head struct {
+		Type WebSocketFrameType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, fmt.Errorf("events: decode WebSocket frame type: %w", err)
+	}
+	f, err := newFrame(head.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, fmt.Errorf("events: decode %q frame: %w", head.Type, err)
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// DecodeClientFrame decodes a frame received by the hub, rejecting frames only the hub may send
+func DecodeClientFrame(data []byte) (WebSocketFrame, error) {
+	f, err := DecodeFrame(data)
+	if err != nil {
+		return nil, err
+	}
+	if !f.FrameType().FromClient() {
+		return nil, fmt.Errorf("%w: clients may not send %q", ErrUnknownFrameType, f.FrameType())
+	}
+	return f, nil
+}
+
+// frameType checks the type field, which EncodeFrame sets
+func (v *validator) frameType(got, want WebSocketFrameType) {
+	if got != want {
+		v.fail("type", "must be %q, got %q", want, got)
+	}
+}
+
+func (v *validator) offset(field string, offset int64) {
+	if offset < 0 {
+		v.fail(field, "must not be negative")
+	}
+}
+
+// validate checks that the filter selects something and names known event types
+func (f SubscriptionFilter) validate(v *validator) {
+	if len(f.MissionIDs) == 0 && len(f.AreaIDs) == 0 && len(f.EventTypes) == 0 {
+		v.fail("filter", "must set missionIds, areaIds or eventTypes")
+	}
+	for i, id := range f.MissionIDs {
+		v.required(fmt.Sprintf("filter.missionIds[%d]", i), id)
+	}
+	for i, id := range f.AreaIDs {
+		v.required(fmt.Sprintf("filter.areaIds[%d]", i), id)
+	}
+	for i, t := range f.EventTypes {
+		if !IsValidEventType(t) && !IsRegistered(t) {
+			v.fail(fmt.Sprintf("filter.eventTypes[%d]", i), "must be a known event type, got %q", t)
+		}
+	}
+}
+
+// Validate checks the request and subscription IDs and the filter
+func (f *SubscribeFrame) Validate() error {
+	v := &validator{}
+	v.frameType(f.Type, FrameSubscribe)
+	v.required("requestId", f.RequestID)
+	v.required("subscriptionId", f.SubscriptionID)
+	f.Filter.validate(v)
+	return v.err()
+}
+
+// Validate checks the request and subscription IDs
+func (f *UnsubscribeFrame) Validate() error {
+	v := &validator{}
+	v.frameType(f.Type, FrameUnsubscribe)
+	v.required("requestId", f.RequestID)
+	v.required("subscriptionId", f.SubscriptionID)
+	return v.err()
+}
+
+// Validate checks the request and subscription IDs and the offset
+func (f *ResumeFrame) Validate() error {
+	v := &validator{}
+	v.frameType(f.Type, FrameResume)
+	v.required("requestId", f.RequestID)
+	v.required("subscriptionId", f.SubscriptionID)
+	v.offset("offset", f.Offset)
+	return v.err()
+}
+
+// Validate checks that the ack confirms either a request or an offset of a subscription
+func (f *AckFrame) Validate() error {
+	v := &validator{}
+	v.frameType(f.Type, FrameAck)
+	v.offset("offset", f.Offset)
+	if f.RequestID == "" {
+		v.required("subscriptionId", f.SubscriptionID)
+		if f.Offset == 0 {
+			v.fail("offset", "is required when requestId is not set")
+		}
+	}
+	return v.err()
+}
+
+// Validate checks the timestamp
+func (f *PingFrame) Validate() error {
+	v := &validator{}
+	v.frameType(f.Type, FramePing)
+	if f.Timestamp.IsZero() {
+		v.fail("timestamp", "is required")
+	}
+	return v.err()
+}
+
+// Validate checks the timestamp
+func (f *PongFrame) Validate() error {
+	v := &validator{}
+	v.frameType(f.Type, FramePong)
+	if f.Timestamp.IsZero() {
+		v.fail("timestamp", "is required")
+	}
+	return v.err()
+}
+
+// Validate checks the error code and message
+func (f *ErrorFrame) Validate() error {
+	v := &validator{}
+	v.frameType(f.Type, FrameError)
+	if !IsValidErrorCode(f.Code) {
+		v.fail("code", "must be a valid error code, got %q", f.Code)
+	}
+	v.required("message", f.Message)
+	return v.err()
+}
+
+// Validate checks an event frame: the event type, subscription and offset
+func (m *WebSocketMessage) Validate() error {
+	v := &validator{}
+	v.frameType(WebSocketFrameType(m.Type), FrameEvent)
+	if !IsValidEventType(m.Event) && !IsRegistered(m.Event) {
+		v.fail("event", "must be a known event type, got %q", m.Event)
+	}
+	if m.Data == nil {
+		v.fail("data", "is required")
+	}
+	v.offset("offset", m.Offset)
+	if m.Offset > 0 {
+		v.required("subscriptionId", m.SubscriptionID)
+	}
+	return v.err()
+}
@@ -0,0 +1,108 @@
+package events
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFrameRoundTrip(t *testing.T) {
+	ts := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	frames := []WebSocketFrame{
+		&SubscribeFrame{RequestID: "r1", SubscriptionID: "s1", Filter: SubscriptionFilter{
+			MissionIDs: []string{"m1"}, EventTypes: []EventType{MissionCreated, TacticalCommandCreated},
+		}},
+		&UnsubscribeFrame{RequestID: "r2", SubscriptionID: "s1"},
+		&ResumeFrame{RequestID: "r3", SubscriptionID: "s1", Offset: 1041},
+		&AckFrame{RequestID: "r1", SubscriptionID: "s1"},
+		&AckFrame{SubscriptionID: "s1", Offset: 1042},
+		&PingFrame{Timestamp: ts},
+		&PongFrame{Timestamp: ts},
+		NewErrorFrame("r3", ErrorCodeOffsetExpired, "offset 1041 is no longer held"),
+		&WebSocketMessage{Event: MissionCreated, Data: map[string]any{"missionId": "m1"}, Timestamp: ts, SubscriptionID: "s1", Offset: 1042},
+	}
+	for _, f := range frames {
+		data, err := EncodeFrame(f)
+		if err != nil {
+			t.Fatalf("encode %T: %v", f, err)
+		}
+		got, err := DecodeFrame(data)
+		if err != nil {
+			t.Fatalf("decode %s: %v", data, err)
+		}
+		if !reflect.DeepEqual(got, f) {
+			t.Errorf("round trip of %s\n got: %#v\nwant: %#v", data, got, f)
+		}
+	}
+}
+
+func TestDecodeFrameRejectsInvalid(t *testing.T) {
+	if _, err := DecodeFrame([]byte(`{"type":"hello"}`)); !errors.Is(err, ErrUnknownFrameType) {
+		t.Errorf("unknown type: expected ErrUnknownFrameType, got %v", err)
+	}
+	if _, err := DecodeFrame([]byte(`{"requestId":"r1"}`)); !errors.Is(err, ErrUnknownFrameType) {
+		t.Errorf("missing type: expected ErrUnknownFrameType, got %v", err)
+	}
+
+	invalid := map[string]string{
+		"empty filter":       `{"type":"subscribe","requestId":"r1","subscriptionId":"s1","filter":{}}`,
+		"unknown event type": `{"type":"subscribe","requestId":"r1","subscriptionId":"s1","filter":{"eventTypes":["nope"]}}`,
+		"no subscription":    `{"type":"unsubscribe","requestId":"r1"}`,
+		"negative offset":    `{"type":"resume","requestId":"r1","subscriptionId":"s1","offset":-1}`,
+		"empty ack":          `{"type":"ack","subscriptionId":"s1"}`,
+		"ping without time":  `{"type":"ping"}`,
+		"unknown error code": `{"type":"error","code":"oops","message":"x"}`,
+		"event without data": `{"type":"event","event":"mission_created","timestamp":"2025-03-14T15:09:26Z"}`,
+	}
+	for name, data := range invalid {
+		var verrs ValidationErrors
+		if _, err := DecodeFrame([]byte(data)); !errors.As(err, &verrs) {
+			t.Errorf("%s: expected ValidationErrors, got %v", name, err)
+		}
+	}
+}
+
+func TestEncodeFrameSetsType(t *testing.T) {
+	data, err := EncodeFrame(&PingFrame{Type: FramePong, Timestamp: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := DecodeFrame(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.FrameType() != FramePing {
+		t.Errorf("decoded %q, want ping", f.FrameType())
+	}
+}
+
+func TestDecodeClientFrame(t *testing.T) {
+	if _, err := DecodeClientFrame([]byte(`{"type":"ping","timestamp":"2025-03-14T15:09:26Z"}`)); err != nil {
+		t.Errorf("ping: %v", err)
+	}
+	if _, err := DecodeClientFrame([]byte(`{"type":"error","code":"internal","message":"x"}`)); !errors.Is(err, ErrUnknownFrameType) {
+		t.Errorf("error frame from client: expected ErrUnknownFrameType, got %v", err)
+	}
+}
+
+func TestSubscriptionFilterMatches(t *testing.T) {
+	f := SubscriptionFilter{MissionIDs: []string{"m1"}, EventTypes: []EventType{MissionChatMessageEvent}}
+	if !f.Matches(MissionChatMessageEvent, "m1") {
+		t.Error("expected match on mission and type")
+	}
+	if f.Matches(MissionChatMessageEvent, "m2") {
+		t.Error("other mission must not match")
+	}
+	if f.Matches(MissionCreated, "m1") {
+		t.Error("other event type must not match")
+	}
+
+	area := SubscriptionFilter{AreaIDs: []string{"a1", "a2"}}
+	if !area.Matches(AssetUpdateEvent, "", "a3", "a2") {
+		t.Error("expected match on any listed area")
+	}
+	if area.Matches(AssetUpdateEvent, "") {
+		t.Error("event without area must not match an area filter")
+	}
+}
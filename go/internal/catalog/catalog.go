@@ -0,0 +1,178 @@
+// Package catalog lists the contract types that repository tooling iterates over:
+// schema generation, fixtures and classification checks.
+// New models must be added to Models so that tooling picks them up.
+package catalog
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+// Models returns every exported struct in the models package
+func Models() []reflect.Type {
+	return []reflect.Type{
+		reflect.TypeOf(models.Alert{}),
+		reflect.TypeOf(models.Asset{}),
+		reflect.TypeOf(models.AssetEventGroup{}),
+		reflect.TypeOf(models.Event{}),
+		reflect.TypeOf(models.GeoJSONPoint{}),
+		reflect.TypeOf(models.GroupedEventsResponse{}),
+		reflect.TypeOf(models.AssetRecall{}),
+		reflect.TypeOf(models.AuditLog{}),
+		reflect.TypeOf(models.GeoPoint{}),
+		reflect.TypeOf(models.GeoLocation{}),
+		reflect.TypeOf(models.Coordinate{}),
+		reflect.TypeOf(models.GridSlot{}),
+		reflect.TypeOf(models.GridConfig{}),
+		reflect.TypeOf(models.CompositionStatus{}),
+		reflect.TypeOf(models.ProfileConfig{}),
+		reflect.TypeOf(models.FireEvent{}),
+		reflect.TypeOf(models.FWIInfo{}),
+		reflect.TypeOf(models.FireDetail{}),
+		reflect.TypeOf(models.ScoreFactors{}),
+		reflect.TypeOf(models.MonitoredLocation{}),
+		reflect.TypeOf(models.FireData{}),
+		reflect.TypeOf(models.BoundingBox{}),
+		reflect.TypeOf(models.Area{}),
+		reflect.TypeOf(models.Location{}),
+		reflect.TypeOf(models.CreateLocationRequest{}),
+		reflect.TypeOf(models.UpdateLocationRequest{}),
+		reflect.TypeOf(models.CreateAreaRequest{}),
+		reflect.TypeOf(models.UpdateAreaRequest{}),
+		reflect.TypeOf(models.Mission{}),
+		reflect.TypeOf(models.CreateMissionRequest{}),
+		reflect.TypeOf(models.UpdateMissionRequest{}),
+		reflect.TypeOf(models.ClaimMissionRequest{}),
+		reflect.TypeOf(models.CompleteMissionRequest{}),
+		reflect.TypeOf(models.DispatchResponseSummary{}),
+		reflect.TypeOf(models.EnrichedDispatch{}),
+		reflect.TypeOf(models.EnrichedMission{}),
+		reflect.TypeOf(models.AddDispatchToMissionRequest{}),
+		reflect.TypeOf(models.DirectCreateMissionRequest{}),
+		reflect.TypeOf(models.MissionChatMessage{}),
+		reflect.TypeOf(models.TypingUser{}),
+		reflect.TypeOf(models.TypingStatus{}),
+		reflect.TypeOf(models.SendMissionChatMessageRequest{}),
+		reflect.TypeOf(models.UpdateTypingStatusRequest{}),
+		reflect.TypeOf(models.MissionChatResponse{}),
+		reflect.TypeOf(models.OutboxRecord{}),
+		reflect.TypeOf(models.CommandTarget{}),
+		reflect.TypeOf(models.CommandResponse{}),
+		reflect.TypeOf(models.CommandStatusUpdate{}),
+		reflect.TypeOf(models.TacticalGeoLocation{}),
+		reflect.TypeOf(models.TacticalGeoArea{}),
+		reflect.TypeOf(models.TacticalCommand{}),
+		reflect.TypeOf(models.CreateTacticalCommandRequest{}),
+		reflect.TypeOf(models.RespondToTacticalCommandRequest{}),
+		reflect.TypeOf(models.UpdateTacticalCommandStatusRequest{}),
+		reflect.TypeOf(models.TacticalCommandFilter{}),
+		reflect.TypeOf(models.Team{}),
+		reflect.TypeOf(models.TeamWithAssets{}),
+		reflect.TypeOf(models.User{}),
+		reflect.TypeOf(models.UserSession{}),
+	}
+}
+
+// EventPayload is a registered payload struct and the event types that decode into it
+type EventPayload struct {
+	Type       reflect.Type
+	EventTypes []events.EventType
+}
+
+// EventPayloads returns the payload structs registered in the events package,
+// sorted by struct name
+func EventPayloads() []EventPayload {
+	byType := map[reflect.Type]*EventPayload{}
+	for _, eventType := range events.RegisteredTypes() {
+		payload, err := events.NewPayload(eventType)
+		if err != nil {
+			continue
+		}
+		t := reflect.TypeOf(payload).Elem()
+		p, ok := byType[t]
+		if !ok {
+			p = &EventPayload{Type: t}
+			byType[t] = p
+		}
+		p.EventTypes = append(p.EventTypes, eventType)
+	}
+
+	payloads := make([]EventPayload, 0, len(byType))
+	for _, p := range byType {
+		payloads = append(payloads, *p)
+	}
+	sort.Slice(payloads, func(i, j int) bool { return payloads[i].Type.Name() < payloads[j].Type.Name() })
+	return payloads
+}
+
+// EventMessages returns event package structs that travel on the wire but are not
+// registered payloads
+func EventMessages() []reflect.Type {
+	return []reflect.Type{
+		reflect.TypeOf(events.BaseEvent{}),
+		reflect.TypeOf(events.FireEventSchema{}),
+		reflect.TypeOf(events.FireEventMessage{}),
+		reflect.TypeOf(events.WebSocketMessage{}),
+		reflect.TypeOf(events.SubscribeFrame{}),
+		reflect.TypeOf(events.UnsubscribeFrame{}),
+		reflect.TypeOf(events.ResumeFrame{}),
+		reflect.TypeOf(events.AckFrame{}),
+		reflect.TypeOf(events.PingFrame{}),
+		reflect.TypeOf(events.PongFrame{}),
+		reflect.TypeOf(events.ErrorFrame{}),
+		reflect.TypeOf(events.DeadLetterEvent{}),
+	}
+}
+
+// Enums returns the allowed values of named contract types that are backed by constants
+func Enums() map[reflect.Type][]any {
+	enums := map[reflect.Type][]any{}
+	add := func(t reflect.Type, values ...any) { enums[t] = values }
+
+	add(reflect.TypeOf(models.MissionStatus("")), toAny(models.ValidMissionStatuses())...)
+	add(reflect.TypeOf(models.TacticalCommandStatus("")), toAny(models.ValidStatuses())...)
+	add(reflect.TypeOf(models.TacticalCommandCategory("")), toAny(models.ValidCategories())...)
+	add(reflect.TypeOf(models.TacticalCommandPriority("")), toAny(models.ValidPriorities())...)
+	add(reflect.TypeOf(models.AssetRecallReason("")), toAny(models.ValidRecallReasons())...)
+	add(reflect.TypeOf(models.OutboxStatus("")), toAny(models.ValidOutboxStatuses())...)
+	add(reflect.TypeOf(models.TeamStatus("")),
+		models.TeamStatusActive, models.TeamStatusInactive, models.TeamStatusDeployed)
+	add(reflect.TypeOf(models.UserRole("")),
+		models.RoleAdmin, models.RoleOperator, models.RoleFieldAgent)
+	add(reflect.TypeOf(models.StreamProfile(0)),
+		models.ProfileBackground, models.ProfileMonitoring, models.ProfileMissionCritical)
+	add(reflect.TypeOf(models.AuditActionType("")),
+		models.AuditActionMissionCreated,
+		models.AuditActionMissionClaimed,
+		models.AuditActionMissionCompleted,
+		models.AuditActionMissionArchived,
+		models.AuditActionMissionDeleted,
+		models.AuditActionAssetStatusChanged,
+		models.AuditActionEventCorrelated,
+		models.AuditActionEventSuggested,
+		models.AuditActionEventApproved,
+		models.AuditActionEventRejected,
+		models.AuditActionOperatorOrder,
+		models.AuditActionOperatorNote,
+		models.AuditActionCommandReceived,
+		models.AuditActionCommandAccepted,
+		models.AuditActionCommandDeclined,
+		models.AuditActionCommandStarted,
+		models.AuditActionCommandCompleted,
+	)
+	add(reflect.TypeOf(events.EventType("")), toAny(events.ValidEventTypes())...)
+	add(reflect.TypeOf(events.WebSocketFrameType("")), toAny(events.ValidFrameTypes())...)
+	add(reflect.TypeOf(events.WebSocketErrorCode("")), toAny(events.ValidErrorCodes())...)
+	return enums
+}
+
+func toAny[T any](values []T) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
@@ -0,0 +1,331 @@
+// Package fixtures builds the golden JSON corpus in testdata/fixtures: one canonical
+// sample per event type and per model, plus its zero value. Go tests check the corpus
+// round-trips byte for byte; the TypeScript and Python packages read the same files to
+// check their own types against the Go wire format.
+//
+// Samples are deterministic. Every field is set, so the full sample shows every key a
+// consumer can receive; the zero sample shows which keys are always present.
+package fixtures
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/internal/catalog"
+)
+
+const (
+	// ZeroSuffix marks the zero-value sample of a type
+	ZeroSuffix = ".zero.json"
+	// sampleSource is the BaseEvent.Source of event samples
+	sampleSource = "phlx-contracts-fixtures"
+	// maxDepth stops self-referencing structs from recursing forever
+	maxDepth = 6
+)
+
+var (
+	// SampleTime is the value of every time.Time in the corpus
+	SampleTime = time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	// SampleObjectID is the value of every primitive.ObjectID in the corpus
+	SampleObjectID, _ = primitive.ObjectIDFromHex("65f3a1b2c3d4e5f601234567")
+)
+
+// Fixture is one file of the corpus
+type Fixture struct {
+	// Path relative to the corpus root, e.g. "events/mission_created.json"
+	Path string
+	// Type is the struct the file decodes into
+	Type reflect.Type
+	// EventType is set for event samples
+	EventType events.EventType
+	Value     any
+}
+
+// All returns the corpus in path order: events/<event type>.json and models/<Model>.json,
+// each followed by its zero sample
+func All() []Fixture {
+	var out []Fixture
+	for _, eventType := range events.RegisteredTypes() {
+		payload, err := events.NewPayload(eventType)
+		if err != nil {
+			continue
+		}
+		t := reflect.TypeOf(payload).Elem()
+		name := "events/" + string(eventType)
+		out = append(out,
+			Fixture{Path: name + ".json", Type: t, EventType: eventType, Value: EventSample(eventType, t)},
+			Fixture{Path: name + ZeroSuffix, Type: t, EventType: eventType, Value: zeroEvent(eventType, t)},
+		)
+	}
+	for _, t := range catalog.Models() {
+		name := "models/" + t.Name()
+		out = append(out,
+			Fixture{Path: name + ".json", Type: t, Value: Sample(t).Interface()},
+			Fixture{Path: name + ZeroSuffix, Type: t, Value: reflect.New(t).Interface()},
+		)
+	}
+	return out
+}
+
+// Encode writes a value the way the corpus stores it: indented JSON with a trailing newline
+func Encode(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Sample returns a pointer to a value of t with every field set
+func Sample(t reflect.Type) reflect.Value {
+	v := reflect.New(t)
+	fill(v.Elem(), "", 0)
+	return v
+}
+
+// EventSample returns a payload of t for the event type with every field set, including
+// the causality, sequence, tenant and encryption fields
+func EventSample(eventType events.EventType, t reflect.Type) any {
+	v := Sample(t)
+	e := v.Interface().(events.Event)
+	*e.EventBase() = events.BaseEvent{
+		ID:            "evt-" + strings.NewReplacer(".", "-", "_", "-").Replace(string(eventType)),
+		Type:          eventType,
+		Timestamp:     SampleTime,
+		Source:        sampleSource,
+		SchemaVersion: events.CurrentSchemaVersion(eventType),
+		CorrelationID: "evt-incident-root",
+		CausationID:   "evt-parent",
+		TraceParent:   "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		TraceState:    "phlx=fixtures",
+		AggregateID:   "sample-aggregateId",
+		Sequence:      42,
+		TenantID:      "sample-tenant",
+		Encryption: &events.FieldEncryption{
+			KeyID:      "sample-keyId",
+			DataKey:    []byte("sample-dataKey"),
+			Fields:     []string{"sample-field"},
+			Ciphertext: []byte("sample-ciphertext"),
+		},
+	}
+	applyEventOverrides(v.Elem())
+	return e
+}
+
+// zeroEvent returns the zero payload with only its event type set, so it can still be dispatched
+func zeroEvent(eventType events.EventType, t reflect.Type) any {
+	e := reflect.New(t).Interface().(events.Event)
+	e.EventBase().Type = eventType
+	return e
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	objectIDType = reflect.TypeOf(primitive.ObjectID{})
+)
+
+// fill sets v to a deterministic non-zero value; name is the JSON key it is stored under
+func fill(v reflect.Value, name string, depth int) {
+	if values, ok := catalog.Enums()[v.Type()]; ok && len(values) > 0 {
+		v.Set(reflect.ValueOf(values[0]).Convert(v.Type()))
+		return
+	}
+	switch v.Type() {
+	case timeType:
+		v.Set(reflect.ValueOf(SampleTime))
+		return
+	case objectIDType:
+		v.Set(reflect.ValueOf(SampleObjectID))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(sampleString(name))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.Set(reflect.ValueOf(42).Convert(v.Type()))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(12.5)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(sampleString(name)))
+	case reflect.Pointer:
+		if depth >= maxDepth {
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem(), name, depth+1)
+	case reflect.Slice:
+		if depth >= maxDepth {
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(v.Index(0), name, depth+1)
+	case reflect.Map:
+		if depth >= maxDepth {
+			return
+		}
+		m := reflect.MakeMap(v.Type())
+		key := reflect.New(v.Type().Key()).Elem()
+		fill(key, "key", depth+1)
+		elem := reflect.New(v.Type().Elem()).Elem()
+		fill(elem, "value", depth+1)
+		m.SetMapIndex(key, elem)
+		v.Set(m)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			key, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if key == "" {
+				key = f.Name
+			}
+			fill(v.Field(i), key, depth+1)
+		}
+	}
+}
+
+// sampleString derives a readable value from the JSON key
+func sampleString(name string) string {
+	if name == "" {
+		return "sample"
+	}
+	return "sample-" + name
+}
+
+// eventOverrides replaces generated values that event validation would reject,
+// keyed by struct name and JSON path
+var eventOverrides = map[string]map[string]any{
+	"AssetUpdateEventData":           {"oldStatus": "available", "newStatus": "dispatched"},
+	"AssetRecallEventData":           {"reason": "low_battery"},
+	"AIAnalysisEventData":            {"events.severity": "high"},
+	"EmergencyNotificationEventData": {"severity": "high"},
+	"ChatMessageEventData":           {"sender": "system"},
+	"SystemStatusEventData":          {"status": "Normal", "previousStatus": "Emergency"},
+	"VideoProcessingEventData":       {"jobType": "frame_extraction", "status": "running"},
+	"EventAnalysisEventData":         {"severity": "high", "category": "safety"},
+	"MissionCreatedEventData":        {"priority": "high", "status": "active"},
+	"AIMissionSuggestionEventData": {
+		"tacticalCommands.targetType": "team",
+		"tacticalCommands.priority":   "immediate",
+		"tacticalCommands.category":   "movement",
+	},
+	"TacticalCommandCreatedEventData": {
+		"targets.targetType":   "team",
+		"priority":             "immediate",
+		"commandSource":        "operator",
+		"category":             "movement",
+		"areaOfOperation.type": "circle",
+	},
+	"TacticalCommandResponseEventData": {"targetType": "team", "decision": "accepted", "newStatus": "accepted"},
+	"TacticalCommandStatusEventData":   {"oldStatus": "accepted", "newStatus": "in_progress"},
+	"FireAlertCreatedEventData": {
+		"severity": "high", "alertId": "alert-001", "alert.id": "alert-001",
+	},
+	"FireRiskEventData":           {"fireEvent.risk_level": "high"},
+	"MissionChatMessageEventData": {"senderRole": "operator"},
+}
+
+// applyEventOverrides sets the override values on a filled payload
+func applyEventOverrides(v reflect.Value) {
+	for path, value := range eventOverrides[v.Type().Name()] {
+		setPath(v, strings.Split(path, "."), value)
+	}
+}
+
+// setPath sets the field at a JSON path, descending into pointers and every slice element
+func setPath(v reflect.Value, path []string, value any) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			setPath(v.Elem(), path, value)
+		}
+		return
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			setPath(v.Index(i), path, value)
+		}
+		return
+	case reflect.Struct:
+	default:
+		return
+	}
+	if len(path) == 0 {
+		return
+	}
+	f, ok := fieldByJSONName(v, path[0])
+	if !ok {
+		panic(fmt.Sprintf("fixtures: %s has no JSON field %q", v.Type(), path[0]))
+	}
+	if len(path) > 1 {
+		setPath(f, path[1:], value)
+		return
+	}
+	f.Set(reflect.ValueOf(value).Convert(f.Type()))
+}
+
+// fieldByJSONName finds a field by JSON key, including fields promoted from embedded structs
+func fieldByJSONName(v reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		key, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if key == name {
+			return v.Field(i), true
+		}
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Type().Field(i); f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if fv, ok := fieldByJSONName(v.Field(i), name); ok {
+				return fv, true
+			}
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// index is written to index.json and maps event types and models to their sample files
+type index struct {
+	Events map[string]string `json:"events"`
+	Models map[string]string `json:"models"`
+}
+
+// Files renders the corpus, keyed by slash-separated path relative to the corpus root
+func Files() (map[string][]byte, error) {
+	files := map[string][]byte{}
+	idx := index{Events: map[string]string{}, Models: map[string]string{}}
+	for _, f := range All() {
+		data, err := Encode(f.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Path, err)
+		}
+		files[f.Path] = data
+		if strings.HasSuffix(f.Path, ZeroSuffix) {
+			continue
+		}
+		if f.EventType != "" {
+			idx.Events[string(f.EventType)] = f.Path
+		} else {
+			idx.Models[f.Type.Name()] = f.Path
+		}
+	}
+	data, err := Encode(idx)
+	if err != nil {
+		return nil, err
+	}
+	files["index.json"] = data
+	return files, nil
+}
@@ -0,0 +1,166 @@
+package fixtures
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+)
+
+// corpusDir is testdata/fixtures at the repository root
+var corpusDir = filepath.Join("..", "..", "..", "testdata", "fixtures")
+
+func TestCorpusUpToDate(t *testing.T) {
+	files, err := Files()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rel, want := range files {
+		got, err := os.ReadFile(filepath.Join(corpusDir, filepath.FromSlash(rel)))
+		if err != nil {
+			t.Errorf("%s: %v", rel, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is stale", rel)
+		}
+	}
+	filepath.WalkDir(corpusDir, func(p string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			rel, _ := filepath.Rel(corpusDir, p)
+			if _, ok := files[filepath.ToSlash(rel)]; !ok {
+				t.Errorf("%s is not generated", rel)
+			}
+		}
+		return nil
+	})
+	if t.Failed() {
+		t.Log("regenerate with: go run ./cmd/phlx-fixtures")
+	}
+}
+
+func TestRoundTripByteStable(t *testing.T) {
+	for _, f := range All() {
+		t.Run(f.Path, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join(corpusDir, filepath.FromSlash(f.Path)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var decoded any
+			if f.EventType != "" {
+				// Events go through the registry, as consumers decode them
+				decoded, err = events.Decode(data)
+			} else {
+				decoded = reflect.New(f.Type).Interface()
+				err = json.Unmarshal(data, decoded)
+			}
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if reflect.TypeOf(decoded).Elem() != f.Type {
+				t.Fatalf("decoded into %T, want %s", decoded, f.Type)
+			}
+
+			got, err := Encode(decoded)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("round trip is not byte-stable\n got: %s\nwant: %s", got, data)
+			}
+		})
+	}
+}
+
+func TestEventSamplesAreValid(t *testing.T) {
+	for _, f := range All() {
+		if f.EventType == "" || strings.HasSuffix(f.Path, ZeroSuffix) {
+			continue
+		}
+		if err := events.Validate(f.Value); err != nil {
+			t.Errorf("%s: %v", f.Path, err)
+		}
+	}
+}
+
+// TestOmitEmpty checks every key of every type: full samples carry all of them, and
+// zero samples carry exactly the keys without omitempty
+func TestOmitEmpty(t *testing.T) {
+	for _, f := range All() {
+		t.Run(f.Path, func(t *testing.T) {
+			data, err := Encode(f.Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got map[string]json.RawMessage
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatal(err)
+			}
+
+			zero := strings.HasSuffix(f.Path, ZeroSuffix)
+			for _, k := range jsonKeys(f.Type) {
+				_, present := got[k.name]
+				switch {
+				case !zero && !present:
+					t.Errorf("full sample is missing %q", k.name)
+				case zero && k.omitEmpty && present:
+					t.Errorf("zero sample has omitempty key %q: %s", k.name, got[k.name])
+				case zero && !k.omitEmpty && !present:
+					t.Errorf("zero sample is missing required key %q", k.name)
+				}
+				delete(got, k.name)
+			}
+			for name := range got {
+				t.Errorf("unexpected key %q", name)
+			}
+		})
+	}
+}
+
+type jsonKey struct {
+	name      string
+	omitEmpty bool
+}
+
+// jsonKeys lists the top-level keys of a struct the way encoding/json does:
+// embedded struct fields are promoted and shallower fields shadow deeper ones
+func jsonKeys(t reflect.Type) []jsonKey {
+	var keys []jsonKey
+	seen := map[string]bool{}
+	var embedded []reflect.Type
+	walk := func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("json")
+			if tag == "-" || (!f.IsExported() && !f.Anonymous) {
+				continue
+			}
+			name, opts, _ := strings.Cut(tag, ",")
+			if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+				embedded = append(embedded, f.Type)
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			if !seen[name] {
+				seen[name] = true
+				keys = append(keys, jsonKey{name: name, omitEmpty: strings.Contains(opts, "omitempty")})
+			}
+		}
+	}
+	walk(t)
+	for len(embedded) > 0 {
+		next := embedded[0]
+		embedded = embedded[1:]
+		walk(next)
+	}
+	return keys
+}
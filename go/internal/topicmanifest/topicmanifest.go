@@ -0,0 +1,124 @@
+// Package topicmanifest renders events.TopicManifest as the topic-creation manifest in
+// topics/: topics.json and topics.yaml hold the same topics, with their partitions,
+// replication factor, owner and Kafka topic configs, for provisioning tools to apply.
+//
+// Output is deterministic, so the committed manifest can be checked against the specs.
+package topicmanifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+const (
+	// JSONFile and YAMLFile are the manifest files, relative to the output directory
+	JSONFile = "topics.json"
+	YAMLFile = "topics.yaml"
+	// header starts the YAML manifest; JSON has no comments
+	header = "# Generated by go run ./cmd/phlx-topics from events.TopicManifest. Do not edit.\n"
+)
+
+// Manifest is the document both files encode
+type Manifest struct {
+	Topics []Topic `json:"topics"`
+}
+
+// Topic is one topic to create. Config holds Kafka topic configs, with values as strings
+// as the admin API takes them.
+type Topic struct {
+	Name              string            `json:"name"`
+	Partitions        int               `json:"partitions"`
+	ReplicationFactor int               `json:"replicationFactor"`
+	Owner             string            `json:"owner"`
+	Config            map[string]string `json:"config"`
+}
+
+// Build returns the manifest of events.TopicManifest for the tenants
+func Build(tenantIDs ...string) (Manifest, error) {
+	for _, id := range tenantIDs {
+		if !models.IsValidTenantID(id) {
+			return Manifest{}, fmt.Errorf("invalid tenant ID %q", id)
+		}
+	}
+	var m Manifest
+	for _, s := range events.TopicManifest(tenantIDs...) {
+		if err := s.Validate(); err != nil {
+			return Manifest{}, err
+		}
+		m.Topics = append(m.Topics, Topic{
+			Name:              s.Name,
+			Partitions:        s.Partitions,
+			ReplicationFactor: s.ReplicationFactor,
+			Owner:             s.Owner,
+			Config: map[string]string{
+				"cleanup.policy": string(s.Cleanup),
+				"retention.ms":   strconv.FormatInt(s.RetentionMillis(), 10),
+			},
+		})
+	}
+	return m, nil
+}
+
+// JSON encodes a manifest as indented JSON with a trailing newline
+func JSON(m Manifest) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(m); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// YAML encodes a manifest as YAML. Strings are written as double-quoted scalars, which
+// share JSON's escaping, so no YAML library is needed.
+func YAML(m Manifest) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("topics:\n")
+	for _, t := range m.Topics {
+		fmt.Fprintf(&buf, "  - name: %s\n", quote(t.Name))
+		fmt.Fprintf(&buf, "    partitions: %d\n", t.Partitions)
+		fmt.Fprintf(&buf, "    replicationFactor: %d\n", t.ReplicationFactor)
+		fmt.Fprintf(&buf, "    owner: %s\n", quote(t.Owner))
+		buf.WriteString("    config:\n")
+		keys := make([]string, 0, len(t.Config))
+		for k := range t.Config {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&buf, "      %s: %s\n", quote(k), quote(t.Config[k]))
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func quote(s string) string {
+	data, _ := json.Marshal(s)
+	return string(data)
+}
+
+// Files renders the manifest for the tenants, keyed by file name
+func Files(tenantIDs ...string) (map[string][]byte, error) {
+	m, err := Build(tenantIDs...)
+	if err != nil {
+		return nil, err
+	}
+	jsonData, err := JSON(m)
+	if err != nil {
+		return nil, err
+	}
+	yamlData, err := YAML(m)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{JSONFile: jsonData, YAMLFile: yamlData}, nil
+}
@@ -0,0 +1,72 @@
+package topicmanifest
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// manifestDir is topics/ at the repository root
+var manifestDir = filepath.Join("..", "..", "..", "topics")
+
+func TestManifestUpToDate(t *testing.T) {
+	files, err := Files()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(manifestDir, name))
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is stale", name)
+		}
+	}
+	if t.Failed() {
+		t.Log("regenerate with: go run ./cmd/phlx-topics -out ../topics")
+	}
+}
+
+func TestBuild(t *testing.T) {
+	m, err := Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	topics := map[string]Topic{}
+	for _, topic := range m.Topics {
+		topics[topic.Name] = topic
+	}
+	if c := topics["mission-events"].Config; c["cleanup.policy"] != "compact" || c["retention.ms"] != "-1" {
+		t.Errorf("mission-events config %v", c)
+	}
+	if topic := topics["location-updates"]; topic.Partitions != 12 || topic.Config["retention.ms"] != "86400000" {
+		t.Errorf("location-updates %+v", topic)
+	}
+	if dlq, ok := topics["video-uploads.dlq"]; !ok || dlq.Partitions != 1 || dlq.Owner != "video-processing-service" {
+		t.Errorf("video-uploads.dlq %+v", dlq)
+	}
+	if _, ok := topics["video-uploads.retry.30s"]; !ok {
+		t.Error("retry topics are missing")
+	}
+}
+
+func TestYAMLListsEveryTopic(t *testing.T) {
+	m, err := Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := YAML(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(string(data), "\n  - name: "); got != len(m.Topics) {
+		t.Errorf("YAML lists %d topics, want %d", got, len(m.Topics))
+	}
+	if !strings.Contains(string(data), `owner: "backend (alert service)"`) {
+		t.Error("owner is not quoted")
+	}
+}
@@ -7,6 +7,7 @@ import "time"
 
 type Alert struct {
 	ID        string    `bson:"_id" json:"id"`
+	TenantID  string    `bson:"tenant_id,omitempty" json:"tenant_id,omitempty"`
 	Type      string    `bson:"type" json:"type"`               // "fire_risk", "asset_danger", "weather_warning"
 	Severity  string    `bson:"severity" json:"severity"`       // "low", "medium", "high", "critical"
 	LocationID   string `bson:"location_id" json:"location_id"`
@@ -18,6 +18,7 @@ const (
 // Asset represents an asset in the system
 type Asset struct {
 	ID               string                 `json:"id"`
+	TenantID         string                 `json:"tenantId,omitempty"`
 	Name             string                 `json:"name"`
 	Type             string                 `json:"type"`
 	Status           string                 `json:"status"`
@@ -30,8 +31,8 @@ type Asset struct {
 	BatteryLevel     int                    `json:"batteryLevel,omitempty"`
 	Members          int                    `json:"members,omitempty"`
 	Vehicle          string                 `json:"vehicle,omitempty"`
-	PulseRate        int                    `json:"pulseRate,omitempty"`
-	OxygenLevel      int                    `json:"oxygenLevel,omitempty"`
+	PulseRate        int                    `json:"pulseRate,omitempty" sensitivity:"sensitive"`
+	OxygenLevel      int                    `json:"oxygenLevel,omitempty" sensitivity:"sensitive"`
 	Location         string                 `json:"location,omitempty"`
 	DispatchTime     *time.Time             `json:"dispatchTime,omitempty"`
 	EstimatedArrival *time.Time             `json:"estimatedArrival,omitempty"`
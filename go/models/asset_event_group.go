@@ -8,6 +8,7 @@ import "time"
 // AssetEventGroup represents grouped events by asset (camera or fire_detector)
 type AssetEventGroup struct {
 	AssetID     string   `json:"assetId"`
+	TenantID    string   `json:"tenantId,omitempty"`
 	AssetName   string   `json:"assetName"`
 	AssetType   string   `json:"assetType"` // "camera" or "fire_detector"
 	EventCount  int      `json:"eventCount"`
@@ -19,6 +20,7 @@ type AssetEventGroup struct {
 // Event represents a single event within a group
 type Event struct {
 	ID          string                 `json:"id"`
+	TenantID    string                 `json:"tenantId,omitempty"`
 	Type        string                 `json:"type"`
 	Timestamp   string                 `json:"timestamp"`
 	Location    *GeoJSONPoint          `json:"location,omitempty"`
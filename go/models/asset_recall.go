@@ -0,0 +1,148 @@
+// Owner: dispatch-asset-service
+// Consumers: backend, mission-command-service, field-agent-app
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AssetRecallReason represents why an asset is recalled to base
+type AssetRecallReason string
+
+const (
+	RecallReasonMissionComplete AssetRecallReason = "mission_complete" // Mission no longer needs the asset
+	RecallReasonLowBattery      AssetRecallReason = "low_battery"      // Battery below safe return threshold
+	RecallReasonVitalsAlert     AssetRecallReason = "vitals_alert"     // Personnel vitals out of range
+	RecallReasonWeather         AssetRecallReason = "weather"          // Unsafe weather conditions
+	RecallReasonMaintenance     AssetRecallReason = "maintenance"      // Asset needs servicing
+	RecallReasonOperatorOrder   AssetRecallReason = "operator_order"   // Operator decision, see notes
+)
+
+// ErrAssetNotRecallable is returned when an asset is not in a state that allows a recall
+var ErrAssetNotRecallable = errors.New("asset cannot be recalled")
+
+// AssetRecall represents an order for a dispatched asset to return to base
+type AssetRecall struct {
+	TenantID       string            `json:"tenantId,omitempty" bson:"tenant_id,omitempty"`
+	AssetID        string            `json:"assetId" bson:"asset_id"`
+	MissionID      string            `json:"missionId,omitempty" bson:"mission_id,omitempty"` // Mission the asset is pulled from
+	Reason         AssetRecallReason `json:"reason" bson:"reason"`
+	Notes          string            `json:"notes,omitempty" bson:"notes,omitempty"`
+	ReturnBaseID   string            `json:"returnBaseId" bson:"return_base_id"` // Location ID of the base to return to
+	ReturnBaseName string            `json:"returnBaseName,omitempty" bson:"return_base_name,omitempty"`
+	ReturnBy       time.Time         `json:"returnBy" bson:"return_by"`     // Deadline for arriving at base
+	RecalledBy     string            `json:"recalledBy" bson:"recalled_by"` // Operator user ID
+	RecalledByName string            `json:"recalledByName" bson:"recalled_by_name" sensitivity:"pii"`
+	IssuedAt       time.Time         `json:"issuedAt" bson:"issued_at"`
+}
+
+// assetStatusTransitions lists the statuses each asset status may move to
+var assetStatusTransitions = map[string][]string{
+	AssetStatusAvailable:  {AssetStatusDispatched, AssetStatusOffline},
+	AssetStatusDispatched: {AssetStatusReturning, AssetStatusAvailable, AssetStatusOffline},
+	AssetStatusReturning:  {AssetStatusAvailable, AssetStatusDispatched, AssetStatusOffline},
+	AssetStatusOffline:    {AssetStatusAvailable},
+}
+
+// ValidAssetStatuses returns all valid asset status values
+func ValidAssetStatuses() []string {
+	return []string{
+		AssetStatusAvailable,
+		AssetStatusDispatched,
+		AssetStatusReturning,
+		AssetStatusOffline,
+	}
+}
+
+// IsValidAssetStatusTransition checks if an asset may move from one status to another
+func IsValidAssetStatusTransition(from, to string) bool {
+	for _, s := range assetStatusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidRecallReasons returns all valid recall reason values
+func ValidRecallReasons() []AssetRecallReason {
+	return []AssetRecallReason{
+		RecallReasonMissionComplete,
+		RecallReasonLowBattery,
+		RecallReasonVitalsAlert,
+		RecallReasonWeather,
+		RecallReasonMaintenance,
+		RecallReasonOperatorOrder,
+	}
+}
+
+// IsValidRecallReason checks if a recall reason is valid
+func IsValidRecallReason(reason AssetRecallReason) bool {
+	for _, r := range ValidRecallReasons() {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// CanRecall checks whether the asset can legally move from dispatched to returning
+func (a *Asset) CanRecall() error {
+	if a.Status != AssetStatusDispatched {
+		return fmt.Errorf("%w: %s is %q, must be %q", ErrAssetNotRecallable, a.ID, a.Status, AssetStatusDispatched)
+	}
+	return nil
+}
+
+// Validate checks the recall order is complete, with the rules of the asset_recall event.
+// It returns ValidationErrors listing every invalid field.
+func (r *AssetRecall) Validate() error {
+	var errs ValidationErrors
+	if strings.TrimSpace(r.AssetID) == "" {
+		errs.add("assetId", "is required")
+	}
+	if !IsValidRecallReason(r.Reason) {
+		errs.add("reason", fmt.Sprintf("must be a valid recall reason, got %q", r.Reason))
+	}
+	if r.Reason == RecallReasonOperatorOrder && strings.TrimSpace(r.Notes) == "" {
+		errs.add("notes", "is required")
+	}
+	if strings.TrimSpace(r.ReturnBaseID) == "" {
+		errs.add("returnBaseId", "is required")
+	}
+	if r.ReturnBy.IsZero() {
+		errs.add("returnBy", "is required")
+	} else if !r.IssuedAt.IsZero() && r.ReturnBy.Before(r.IssuedAt) {
+		errs.add("returnBy", "must not be before issuedAt")
+	}
+	if strings.TrimSpace(r.RecalledBy) == "" {
+		errs.add("recalledBy", "is required")
+	}
+	return errs.err()
+}
+
+// ApplyRecall moves a dispatched asset to returning and sets its estimated arrival to the recall deadline
+func (a *Asset) ApplyRecall(r AssetRecall) error {
+	if r.AssetID != a.ID {
+		return fmt.Errorf("recall for asset %s applied to asset %s", r.AssetID, a.ID)
+	}
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if err := a.CanRecall(); err != nil {
+		return err
+	}
+
+	returnBy := r.ReturnBy
+	a.Status = AssetStatusReturning
+	a.EstimatedArrival = &returnBy
+	a.LastUpdated = r.IssuedAt
+	if a.LastUpdated.IsZero() {
+		a.LastUpdated = time.Now()
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package models_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+var issued = time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+
+func validRecall() models.AssetRecall {
+	return models.AssetRecall{
+		AssetID: "asset-1", Reason: models.RecallReasonLowBattery, ReturnBaseID: "base-1",
+		ReturnBy: issued.Add(time.Hour), RecalledBy: "op-1", IssuedAt: issued,
+	}
+}
+
+// invalidFields returns the field paths of the ValidationErrors in err
+func invalidFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var verrs models.ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("%v is not a ValidationErrors", err)
+	}
+	fields := make([]string, len(verrs))
+	for i, fe := range verrs {
+		fields[i] = fe.Field
+	}
+	return fields
+}
+
+func TestAssetRecallValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		mutate func(r *models.AssetRecall)
+		want   []string
+	}{
+		{"valid", func(r *models.AssetRecall) {}, nil},
+		{"operator order with notes", func(r *models.AssetRecall) { r.Reason, r.Notes = models.RecallReasonOperatorOrder, "Crew rotation" }, nil},
+		{"missing asset", func(r *models.AssetRecall) { r.AssetID = " " }, []string{"assetId"}},
+		{"unknown reason", func(r *models.AssetRecall) { r.Reason = "bored" }, []string{"reason"}},
+		{"operator order without notes", func(r *models.AssetRecall) { r.Reason = models.RecallReasonOperatorOrder }, []string{"notes"}},
+		{"missing base", func(r *models.AssetRecall) { r.ReturnBaseID = "" }, []string{"returnBaseId"}},
+		{"missing deadline", func(r *models.AssetRecall) { r.ReturnBy = time.Time{} }, []string{"returnBy"}},
+		{"deadline before issue", func(r *models.AssetRecall) { r.ReturnBy = issued.Add(-time.Minute) }, []string{"returnBy"}},
+		{"missing operator", func(r *models.AssetRecall) { r.RecalledBy = "" }, []string{"recalledBy"}},
+		{"every field", func(r *models.AssetRecall) { *r = models.AssetRecall{} }, []string{"assetId", "reason", "returnBaseId", "returnBy", "recalledBy"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validRecall()
+			tt.mutate(&r)
+			if got := invalidFields(t, r.Validate()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("invalid fields %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyRecall(t *testing.T) {
+	asset := models.Asset{ID: "asset-1", Status: models.AssetStatusDispatched}
+	recall := validRecall()
+	if err := asset.ApplyRecall(recall); err != nil {
+		t.Fatal(err)
+	}
+	if asset.Status != models.AssetStatusReturning || !asset.EstimatedArrival.Equal(recall.ReturnBy) || !asset.LastUpdated.Equal(issued) {
+		t.Errorf("recalled asset %+v", asset)
+	}
+	if err := asset.ApplyRecall(recall); !errors.Is(err, models.ErrAssetNotRecallable) {
+		t.Errorf("second recall: %v", err)
+	}
+
+	dispatched := models.Asset{ID: "asset-1", Status: models.AssetStatusDispatched}
+	recall.Reason = models.RecallReasonOperatorOrder
+	if got := invalidFields(t, dispatched.ApplyRecall(recall)); !reflect.DeepEqual(got, []string{"notes"}) || dispatched.Status != models.AssetStatusDispatched {
+		t.Errorf("invalid recall applied: %v, status %s", got, dispatched.Status)
+	}
+}
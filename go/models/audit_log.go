@@ -44,6 +44,7 @@ const (
 // AuditLog represents a complete audit trail entry for mission actions
 type AuditLog struct {
 	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	TenantID  string             `json:"tenantId,omitempty" bson:"tenant_id,omitempty"`
 	MissionID string             `json:"missionId" bson:"mission_id"`
 	Timestamp time.Time          `json:"timestamp" bson:"timestamp"`
 
@@ -51,7 +52,7 @@ type AuditLog struct {
 	ActionType AuditActionType `json:"actionType" bson:"action_type"`
 	ActorType  string          `json:"actorType" bson:"actor_type"` // "operator", "asset", "ai_agent", "system"
 	ActorID    string          `json:"actorId" bson:"actor_id"`
-	ActorName  string          `json:"actorName,omitempty" bson:"actor_name,omitempty"`
+	ActorName  string          `json:"actorName,omitempty" bson:"actor_name,omitempty" sensitivity:"pii"`
 
 	// Target of action
 	TargetType string `json:"targetType,omitempty" bson:"target_type,omitempty"` // "asset", "dispatch", "event"
@@ -9,6 +9,7 @@ import (
 
 type FireEvent struct {
 	ID           string       `bson:"_id" json:"id"`
+	TenantID     string       `bson:"tenant_id,omitempty" json:"tenant_id,omitempty"`
 	LocationID   string       `bson:"location_id" json:"location_id"`
 	LocationName string       `bson:"location_name" json:"location_name"`
 	LocationType string       `bson:"location_type" json:"location_type"` // "asset", "facility"
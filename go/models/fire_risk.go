@@ -9,6 +9,7 @@ import (
 
 type MonitoredLocation struct {
 	ID       string   `bson:"_id" json:"id"`
+	TenantID string   `bson:"tenant_id,omitempty" json:"tenant_id,omitempty"`
 	Name     string   `bson:"name" json:"name"`
 	Type     string   `bson:"type" json:"type"` // "asset", "facility", etc.
 	Location GeoPoint `bson:"location" json:"location"`
@@ -18,6 +19,7 @@ type MonitoredLocation struct {
 
 type FireData struct {
 	ID             string                 `bson:"_id" json:"id"`
+	TenantID       string                 `bson:"tenant_id,omitempty" json:"tenant_id,omitempty"`
 	Source         string                 `bson:"source" json:"source"`
 	SourceType     string                 `bson:"source_type" json:"source_type"`
 	Timestamp      time.Time              `bson:"timestamp" json:"timestamp"`
@@ -15,6 +15,7 @@ import (
 // Area represents a named zone within a location with polygon boundary
 type Area struct {
 	ID          string       `json:"id" bson:"id"` // UUID within location
+	TenantID    string       `json:"tenantId,omitempty" bson:"tenant_id,omitempty"`
 	Name        string       `json:"name" bson:"name"`
 	Description string       `json:"description,omitempty" bson:"description,omitempty"`
 	Boundary    []Coordinate `json:"boundary" bson:"boundary"` // Polygon points
@@ -31,6 +32,7 @@ type Area struct {
 // Location represents a geographic location with center coordinates and multiple areas
 type Location struct {
 	ID          primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	TenantID    string             `json:"tenantId,omitempty" bson:"tenant_id,omitempty"`
 	Name        string             `json:"name" bson:"name"`
 	Description string             `json:"description,omitempty" bson:"description,omitempty"`
 
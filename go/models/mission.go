@@ -18,9 +18,29 @@ const (
 	MissionStatusArchived  MissionStatus = "archived"
 )
 
+// ValidMissionStatuses returns all valid mission status values
+func ValidMissionStatuses() []MissionStatus {
+	return []MissionStatus{
+		MissionStatusActive,
+		MissionStatusCompleted,
+		MissionStatusArchived,
+	}
+}
+
+// IsValidMissionStatus checks if a mission status is valid
+func IsValidMissionStatus(status MissionStatus) bool {
+	for _, s := range ValidMissionStatuses() {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
 // Mission represents an operator-managed incident with correlated events
 type Mission struct {
 	ID          primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	TenantID    string             `json:"tenantId,omitempty" bson:"tenant_id,omitempty"`
 	Title       string             `json:"title" bson:"title"`
 	Description string             `json:"description" bson:"description"`
 	Status      MissionStatus      `json:"status" bson:"status"`
@@ -28,7 +48,7 @@ type Mission struct {
 
 	// Operator management
 	ClaimedByOperatorID   *string    `json:"claimedByOperatorId,omitempty" bson:"claimed_by_operator_id,omitempty"`
-	ClaimedByOperatorName *string    `json:"claimedByOperatorName,omitempty" bson:"claimed_by_operator_name,omitempty"`
+	ClaimedByOperatorName *string    `json:"claimedByOperatorName,omitempty" bson:"claimed_by_operator_name,omitempty" sensitivity:"pii"`
 	ClaimedAt             *time.Time `json:"claimedAt,omitempty" bson:"claimed_at,omitempty"`
 	CompletedAt           *time.Time `json:"completedAt,omitempty" bson:"completed_at,omitempty"`
 	CompletedByOperatorID *string    `json:"completedByOperatorId,omitempty" bson:"completed_by_operator_id,omitempty"`
@@ -67,7 +87,7 @@ type UpdateMissionRequest struct {
 // ClaimMissionRequest represents the request to claim a mission
 type ClaimMissionRequest struct {
 	OperatorID   string `json:"operatorId" binding:"required"`
-	OperatorName string `json:"operatorName" binding:"required"`
+	OperatorName string `json:"operatorName" binding:"required" sensitivity:"pii"`
 }
 
 // CompleteMissionRequest represents the request to complete a mission
@@ -12,9 +12,10 @@ import (
 // MissionChatMessage represents a human chat message in a mission
 type MissionChatMessage struct {
 	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	TenantID  string             `json:"tenantId,omitempty" bson:"tenant_id,omitempty"`
 	MissionID primitive.ObjectID `json:"missionId" bson:"mission_id"`
 	SenderID  string             `json:"senderId" bson:"sender_id"`
-	SenderName string            `json:"senderName" bson:"sender_name"`
+	SenderName string            `json:"senderName" bson:"sender_name" sensitivity:"pii"`
 	SenderRole string            `json:"senderRole" bson:"sender_role"` // "operator" | "field_agent"
 	Content   string             `json:"content" bson:"content"`
 	Timestamp time.Time          `json:"timestamp" bson:"timestamp"`
@@ -24,7 +25,7 @@ type MissionChatMessage struct {
 // TypingUser represents a user who is currently typing
 type TypingUser struct {
 	UserID   string `json:"userId"`
-	UserName string `json:"userName"`
+	UserName string `json:"userName" sensitivity:"pii"`
 }
 
 // TypingStatus represents the typing status in a mission chat
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Owner: every service that publishes events
+// Consumers: the service's own outbox relay
+
+// OutboxStatus represents the delivery state of an outbox record
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "pending"   // Waiting to be published
+	OutboxStatusPublished OutboxStatus = "published" // Acknowledged by Kafka
+	OutboxStatusFailed    OutboxStatus = "failed"    // Gave up after the maximum number of attempts
+)
+
+// ValidOutboxStatuses returns all valid outbox status values
+func ValidOutboxStatuses() []OutboxStatus {
+	return []OutboxStatus{
+		OutboxStatusPending,
+		OutboxStatusPublished,
+		OutboxStatusFailed,
+	}
+}
+
+// IsValidOutboxStatus checks if an outbox status is valid
+func IsValidOutboxStatus(status OutboxStatus) bool {
+	for _, s := range ValidOutboxStatuses() {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// OutboxRecord is an encoded event waiting to be published to Kafka. Services insert it
+// in the same MongoDB transaction as the aggregate change it announces, so the event is
+// published if and only if the change is committed.
+type OutboxRecord struct {
+	ID          primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	TenantID    string             `json:"tenantId,omitempty" bson:"tenant_id,omitempty"`
+	EventID     string             `json:"eventId" bson:"event_id"`
+	EventType   string             `json:"eventType" bson:"event_type"`
+	Topic       string             `json:"topic" bson:"topic"`
+	Key         string             `json:"key" bson:"key"`                             // Kafka message key; records with one key are published in order
+	Value       []byte             `json:"value" bson:"value"`                         // Encoded event
+	Headers     map[string]string  `json:"headers,omitempty" bson:"headers,omitempty"` // Kafka headers, e.g. content-type and ce_type
+	Status      OutboxStatus       `json:"status" bson:"status"`
+	Attempts    int                `json:"attempts" bson:"attempts"`
+	LastError   string             `json:"lastError,omitempty" bson:"last_error,omitempty"`
+	CreatedAt   time.Time          `json:"createdAt" bson:"created_at"`
+	PublishedAt *time.Time         `json:"publishedAt,omitempty" bson:"published_at,omitempty"`
+}
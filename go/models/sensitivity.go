@@ -0,0 +1,234 @@
+package models
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Sensitive fields.
+//
+// A field that identifies a person, or holds health data or credentials, carries a
+// sensitivity tag next to its json tag:
+//
+//	Email string `bson:"email" json:"email" sensitivity:"pii"`
+//
+// Event payloads use the same tag. Redact masks tagged fields before a value is logged,
+// dumped or exported, and phlx-schemagen writes the classification into the JSON Schema
+// as x-phlx-sensitivity. A classification test walks every model and event payload and
+// fails on untagged fields that look sensitive, so new fields must be classified.
+
+// Sensitivity classifies a field holding personal or confidential data
+type Sensitivity string
+
+const (
+	SensitivityPII       Sensitivity = "pii"       // Identifies a person: names, contact details, network identity
+	SensitivitySensitive Sensitivity = "sensitive" // Health data and credentials
+)
+
+const sensitivityTag = "sensitivity"
+
+// RedactedValue replaces the value of a masked field
+const RedactedValue = "[REDACTED]"
+
+// ValidSensitivities returns all valid sensitivity values
+func ValidSensitivities() []Sensitivity {
+	return []Sensitivity{
+		SensitivityPII,
+		SensitivitySensitive,
+	}
+}
+
+// IsValidSensitivity checks if a sensitivity is valid
+func IsValidSensitivity(sensitivity Sensitivity) bool {
+	for _, s := range ValidSensitivities() {
+		if s == sensitivity {
+			return true
+		}
+	}
+	return false
+}
+
+// SensitivityOf returns the classification of a struct field, or "" if it is not tagged
+func SensitivityOf(f reflect.StructField) Sensitivity {
+	return Sensitivity(f.Tag.Get(sensitivityTag))
+}
+
+// ClassifiedFields returns the tagged fields of a struct type by Go field name,
+// including fields promoted from embedded structs
+func ClassifiedFields(t reflect.Type) map[string]Sensitivity {
+	fields := map[string]Sensitivity{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if s := SensitivityOf(f); s != "" {
+			fields[f.Name] = s
+		}
+		if ft := indirectType(f.Type); f.Anonymous && ft.Kind() == reflect.Struct {
+			for name, s := range ClassifiedFields(ft) {
+				if _, ok := fields[name]; !ok {
+					fields[name] = s
+				}
+			}
+		}
+	}
+	return fields
+}
+
+// Redact returns v as the generic value encoding/json would write for it (objects keyed
+// by JSON name, arrays and scalars) with every non-empty tagged field replaced by
+// RedactedValue, at any depth. Marshal the result to log or export it; v is not modified.
+//
+//	data, _ := json.Marshal(models.Redact(payload))
+//	log.Printf("vitals update: %s", data)
+func Redact(v any) any {
+	return redact(reflect.ValueOf(v))
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+func redact(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	// Types with their own encoding, such as time.Time and ObjectID, are kept as they are
+	// unless they hold tagged fields
+	if t := v.Type(); t.Kind() != reflect.Interface && (t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType)) &&
+		!holdsClassified(t, map[reflect.Type]bool{}) {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return redact(v.Elem())
+	case reflect.Struct:
+		out := map[string]any{}
+		redactStruct(v, out)
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Bytes()
+		}
+		fallthrough
+	case reflect.Array:
+		out := make([]any, v.Len())
+		for i := range out {
+			out[i] = redact(v.Index(i))
+		}
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		out := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out[fmt.Sprint(iter.Key().Interface())] = redact(iter.Value())
+		}
+		return out
+	default:
+		return v.Interface()
+	}
+}
+
+// redactStruct adds the JSON properties of a struct to out the way encoding/json
+// resolves them: fields of embedded structs are promoted unless a shallower field has
+// the same name
+func redactStruct(v reflect.Value, out map[string]any) {
+	t := v.Type()
+	var embedded []reflect.Value
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && indirectType(f.Type).Kind() == reflect.Struct {
+			embedded = append(embedded, v.Field(i))
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		fv := v.Field(i)
+		empty := isEmptyValue(fv)
+		switch {
+		case empty && strings.Contains(","+opts+",", ",omitempty,"):
+		case !empty && SensitivityOf(f) != "":
+			out[name] = RedactedValue
+		default:
+			out[name] = redact(fv)
+		}
+	}
+
+	for _, ev := range embedded {
+		if ev.Kind() == reflect.Pointer {
+			if ev.IsNil() {
+				continue
+			}
+			ev = ev.Elem()
+		}
+		promoted := map[string]any{}
+		redactStruct(ev, promoted)
+		for name, value := range promoted {
+			if _, ok := out[name]; !ok {
+				out[name] = value
+			}
+		}
+	}
+}
+
+// holdsClassified reports whether a tagged field is reachable from t
+func holdsClassified(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return holdsClassified(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if SensitivityOf(t.Field(i)) != "" || holdsClassified(t.Field(i).Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isEmptyValue reports whether omitempty drops a value
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	case reflect.Struct:
+		return false
+	default:
+		return v.IsZero()
+	}
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,214 @@
+package models_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ai-project-787/phlx-contracts/go/events"
+	"github.com/ai-project-787/phlx-contracts/go/internal/catalog"
+	"github.com/ai-project-787/phlx-contracts/go/internal/fixtures"
+	"github.com/ai-project-787/phlx-contracts/go/models"
+)
+
+const (
+	pii       = models.SensitivityPII
+	sensitive = models.SensitivitySensitive
+)
+
+// classified is every tagged field of the contract types, by struct name. A type that
+// is not listed has no tagged fields.
+var classified = map[string]map[string]models.Sensitivity{
+	"Asset":                            {"PulseRate": sensitive, "OxygenLevel": sensitive},
+	"AssetRecall":                      {"RecalledByName": pii},
+	"AuditLog":                         {"ActorName": pii},
+	"ClaimMissionRequest":              {"OperatorName": pii},
+	"CommandResponse":                  {"RespondedByName": pii},
+	"CommandStatusUpdate":              {"ChangedByName": pii},
+	"EnrichedMission":                  {"ClaimedByOperatorName": pii},
+	"Mission":                          {"ClaimedByOperatorName": pii},
+	"MissionChatMessage":               {"SenderName": pii},
+	"TacticalCommand":                  {"CreatedByName": pii},
+	"Team":                             {"CreatedByName": pii},
+	"TeamWithAssets":                   {"CreatedByName": pii},
+	"TypingUser":                       {"UserName": pii},
+	"User":                             {"Email": pii, "Name": pii, "PasswordHash": sensitive},
+	"UserSession":                      {"Token": sensitive, "IPAddress": pii, "UserAgent": pii},
+	"AssetRecallEventData":             {"RecalledByName": pii},
+	"MissionChatMessageEventData":      {"SenderName": pii},
+	"MissionTypingUser":                {"UserName": pii},
+	"TacticalCommandResponseEventData": {"RespondedByName": pii},
+	"TacticalCommandStatusEventData":   {"UpdatedByName": pii},
+	"VitalsUpdateEventData": {
+		"PersonnelName": pii, "PulseRate": sensitive, "OxygenLevel": sensitive, "Temperature": sensitive,
+		"AlertReason": sensitive,
+	},
+}
+
+// looksSensitive matches field names that must be classified
+var looksSensitive = regexp.MustCompile(`(?i)email|password|token|ipaddress|useragent|phone|pulse|oxygen|temperature|(by|operator|sender|user|actor|personnel)name$`)
+
+// contractTypes returns every model, event payload and wire message, with the named
+// structs they reference
+func contractTypes() []reflect.Type {
+	roots := catalog.Models()
+	for _, p := range catalog.EventPayloads() {
+		roots = append(roots, p.Type)
+	}
+	roots = append(roots, catalog.EventMessages()...)
+
+	var types []reflect.Type
+	seen := map[reflect.Type]bool{}
+	var visit func(t reflect.Type)
+	visit = func(t reflect.Type) {
+		switch t.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+			visit(t.Elem())
+			return
+		case reflect.Struct:
+		default:
+			return
+		}
+		if seen[t] || !strings.HasPrefix(t.PkgPath(), "github.com/ai-project-787/phlx-contracts/") {
+			return
+		}
+		seen[t] = true
+		types = append(types, t)
+		for i := 0; i < t.NumField(); i++ {
+			visit(t.Field(i).Type)
+		}
+	}
+	for _, t := range roots {
+		visit(t)
+	}
+	return types
+}
+
+func TestClassification(t *testing.T) {
+	checked := map[string]bool{}
+	for _, typ := range contractTypes() {
+		checked[typ.Name()] = true
+		got := models.ClassifiedFields(typ)
+		if want := classified[typ.Name()]; len(got) != 0 || len(want) != 0 {
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: classified fields %v, want %v", typ.Name(), got, want)
+			}
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			s := models.SensitivityOf(f)
+			if s != "" && !models.IsValidSensitivity(s) {
+				t.Errorf("%s.%s: invalid sensitivity %q", typ.Name(), f.Name, s)
+			}
+			if s == "" && looksSensitive.MatchString(f.Name) {
+				t.Errorf("%s.%s looks sensitive but has no sensitivity tag", typ.Name(), f.Name)
+			}
+		}
+	}
+	for name := range classified {
+		if !checked[name] {
+			t.Errorf("%s is not a contract type", name)
+		}
+	}
+}
+
+func TestRedactEveryType(t *testing.T) {
+	for _, typ := range contractTypes() {
+		t.Run(typ.String(), func(t *testing.T) {
+			sample := fixtures.Sample(typ).Interface()
+			before, err := json.Marshal(sample)
+			if err != nil {
+				t.Fatal(err)
+			}
+			redacted, err := json.Marshal(models.Redact(sample))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if after, _ := json.Marshal(sample); string(after) != string(before) {
+				t.Error("Redact modified its argument")
+			}
+
+			var plain, masked map[string]any
+			if err := json.Unmarshal(before, &plain); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(redacted, &masked); err != nil {
+				t.Fatal(err)
+			}
+			tagged := taggedKeys(typ)
+			for key, value := range plain {
+				switch {
+				case tagged[key]:
+					if masked[key] != models.RedactedValue {
+						t.Errorf("%s = %v, want it masked", key, masked[key])
+					}
+				case !reflect.DeepEqual(masked[key], value) && !holdsRedacted(masked[key]):
+					t.Errorf("%s changed: %v, want %v", key, masked[key], value)
+				}
+			}
+			if len(masked) != len(plain) {
+				t.Errorf("redacted keys %d, want %d", len(masked), len(plain))
+			}
+		})
+	}
+}
+
+// taggedKeys returns the JSON keys of the tagged fields of a struct, including fields
+// promoted from embedded structs
+func taggedKeys(t reflect.Type) map[string]bool {
+	keys := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch {
+		case f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct:
+			for key := range taggedKeys(f.Type) {
+				keys[key] = true
+			}
+		case models.SensitivityOf(f) != "" && name != "-":
+			keys[name] = true
+		}
+	}
+	return keys
+}
+
+// holdsRedacted reports whether a nested value was masked somewhere
+func holdsRedacted(v any) bool {
+	data, _ := json.Marshal(v)
+	return strings.Contains(string(data), models.RedactedValue)
+}
+
+func TestRedact(t *testing.T) {
+	seen := time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+	user := models.User{Email: "ada@example.org", PasswordHash: "$2a$10$x", Name: "Ada", Role: models.RoleOperator, LastLoginAt: &seen}
+	data, _ := json.Marshal(models.Redact(&user))
+	want := `{"active":false,"createdAt":"0001-01-01T00:00:00Z","email":"[REDACTED]","id":"000000000000000000000000",` +
+		`"lastLoginAt":"2025-03-14T15:00:00Z","name":"[REDACTED]","role":"operator","updatedAt":"0001-01-01T00:00:00Z"}`
+	if string(data) != want {
+		t.Errorf("Redact(user)\n got: %s\nwant: %s", data, want)
+	}
+
+	vitals := &events.VitalsUpdateEventData{
+		BaseEvent:   events.BaseEvent{ID: "e1", Type: events.VitalsUpdateEvent, Timestamp: seen, Source: "test"},
+		PersonnelID: "p-7", PersonnelName: "Ada", PulseRate: 72, OxygenLevel: 98,
+	}
+	envelope := events.Envelope[events.VitalsUpdateEventData]{BaseEvent: vitals.BaseEvent, Payload: vitals}
+	for _, v := range []any{vitals, *vitals, envelope, []any{vitals}} {
+		data, _ := json.Marshal(models.Redact(v))
+		for _, leaked := range []string{"Ada", "72", "98"} {
+			if strings.Contains(string(data), leaked) {
+				t.Errorf("Redact(%T) leaks %q: %s", v, leaked, data)
+			}
+		}
+		if !strings.Contains(string(data), `"personnelId":"p-7"`) {
+			t.Errorf("Redact(%T) dropped unclassified fields: %s", v, data)
+		}
+	}
+
+	if models.Redact(nil) != nil || models.Redact((*models.User)(nil)) != nil {
+		t.Error("Redact(nil) is not nil")
+	}
+}
@@ -22,6 +22,16 @@ const (
 	TacticalCommandStatusCancelled       TacticalCommandStatus = "cancelled"        // Cancelled by operator
 )
 
+// tacticalCommandStatusTransitions lists the statuses each command status may move to.
+// Completed and cancelled commands are final.
+var tacticalCommandStatusTransitions = map[TacticalCommandStatus][]TacticalCommandStatus{
+	TacticalCommandStatusPendingApproval: {TacticalCommandStatusPending, TacticalCommandStatusCancelled},
+	TacticalCommandStatusPending:         {TacticalCommandStatusAccepted, TacticalCommandStatusRejected, TacticalCommandStatusInProgress, TacticalCommandStatusCancelled},
+	TacticalCommandStatusAccepted:        {TacticalCommandStatusInProgress, TacticalCommandStatusCompleted, TacticalCommandStatusCancelled},
+	TacticalCommandStatusRejected:        {TacticalCommandStatusPending, TacticalCommandStatusCancelled},
+	TacticalCommandStatusInProgress:      {TacticalCommandStatusCompleted, TacticalCommandStatusCancelled},
+}
+
 // TacticalCommandCategory represents predefined categories for UI icon/color mapping
 type TacticalCommandCategory string
 
@@ -63,7 +73,7 @@ type CommandResponse struct {
 	Decision        string    `json:"decision" bson:"decision"` // "accepted" or "rejected"
 	Notes           string    `json:"notes,omitempty" bson:"notes,omitempty"`
 	RespondedBy     string    `json:"responded_by" bson:"responded_by"`           // User ID who responded
-	RespondedByName string    `json:"responded_by_name" bson:"responded_by_name"` // User name
+	RespondedByName string    `json:"responded_by_name" bson:"responded_by_name" sensitivity:"pii"` // User name
 	RespondedAt     time.Time `json:"responded_at" bson:"responded_at"`
 }
 
@@ -71,7 +81,7 @@ type CommandResponse struct {
 type CommandStatusUpdate struct {
 	Status    TacticalCommandStatus `json:"status" bson:"status"`
 	ChangedBy string                `json:"changed_by" bson:"changed_by"`           // User ID
-	ChangedByName string            `json:"changed_by_name" bson:"changed_by_name"` // User name
+	ChangedByName string            `json:"changed_by_name" bson:"changed_by_name" sensitivity:"pii"` // User name
 	Timestamp time.Time             `json:"timestamp" bson:"timestamp"`
 	Notes     string                `json:"notes,omitempty" bson:"notes,omitempty"`
 }
@@ -95,7 +105,8 @@ type TacticalGeoArea struct {
 
 // TacticalCommand represents a unified command model replacing OperationalCommand and DispatchRequest
 type TacticalCommand struct {
-	ID primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	ID       primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	TenantID string             `json:"tenant_id,omitempty" bson:"tenant_id,omitempty"`
 
 	// Mission Context (required - commands must link to existing missions)
 	MissionID        primitive.ObjectID `json:"mission_id" bson:"mission_id"`
@@ -127,7 +138,7 @@ type TacticalCommand struct {
 	// Metadata
 	Source    string             `json:"source" bson:"source"` // "ai" or "operator"
 	CreatedBy primitive.ObjectID `json:"created_by" bson:"created_by"`
-	CreatedByName string         `json:"created_by_name" bson:"created_by_name"`
+	CreatedByName string         `json:"created_by_name" bson:"created_by_name" sensitivity:"pii"`
 	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
 	UpdatedAt time.Time          `json:"updated_at" bson:"updated_at"`
 
@@ -246,3 +257,18 @@ func IsValidStatus(status TacticalCommandStatus) bool {
 	}
 	return false
 }
+
+// IsValidStatusTransition checks if a command may move from one status to another
+func IsValidStatusTransition(from, to TacticalCommandStatus) bool {
+	for _, s := range tacticalCommandStatusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinalStatus checks if a command status allows no further transitions
+func IsFinalStatus(status TacticalCommandStatus) bool {
+	return IsValidStatus(status) && len(tacticalCommandStatusTransitions[status]) == 0
+}
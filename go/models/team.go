@@ -21,6 +21,7 @@ const (
 // Team represents a group of assets working together
 type Team struct {
 	ID           primitive.ObjectID     `bson:"_id,omitempty" json:"id"`
+	TenantID     string                 `bson:"tenant_id,omitempty" json:"tenantId,omitempty"`
 	Name         string                 `bson:"name" json:"name"`
 	Description  string                 `bson:"description,omitempty" json:"description,omitempty"`
 	Status       TeamStatus             `bson:"status" json:"status"`
@@ -38,7 +39,7 @@ type Team struct {
 
 	// Audit Trail
 	CreatedBy    primitive.ObjectID     `bson:"created_by" json:"createdBy"`
-	CreatedByName string                `bson:"created_by_name" json:"createdByName"`
+	CreatedByName string                `bson:"created_by_name" json:"createdByName" sensitivity:"pii"`
 	CreatedAt    time.Time              `bson:"created_at" json:"createdAt"`
 	UpdatedAt    time.Time              `bson:"updated_at" json:"updatedAt"`
 
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Tenant isolation.
+//
+// Every stored model carries the TenantID of the organisation (agency) it belongs to;
+// single-tenant deployments leave it empty. A record may only reference records of its
+// own tenant, so services check references with the guards below before storing them.
+
+// ErrCrossTenant is returned when a record references a record of another tenant
+var ErrCrossTenant = errors.New("models: cross-tenant reference")
+
+// tenantIDPattern keeps tenant IDs usable as topic and collection name prefixes
+var tenantIDPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{0,62}$`)
+
+// IsValidTenantID checks if a tenant ID is 1-63 lowercase letters, digits and hyphens,
+// starting with a letter
+func IsValidTenantID(id string) bool {
+	return tenantIDPattern.MatchString(id)
+}
+
+// TenantScoped is implemented by every model that belongs to a tenant
+type TenantScoped interface {
+	Tenant() string
+}
+
+func (a Alert) Tenant() string              { return a.TenantID }
+func (a Area) Tenant() string               { return a.TenantID }
+func (a Asset) Tenant() string              { return a.TenantID }
+func (g AssetEventGroup) Tenant() string    { return g.TenantID }
+func (r AssetRecall) Tenant() string        { return r.TenantID }
+func (l AuditLog) Tenant() string           { return l.TenantID }
+func (e Event) Tenant() string              { return e.TenantID }
+func (e FireEvent) Tenant() string          { return e.TenantID }
+func (l MonitoredLocation) Tenant() string  { return l.TenantID }
+func (d FireData) Tenant() string           { return d.TenantID }
+func (l Location) Tenant() string           { return l.TenantID }
+func (m Mission) Tenant() string            { return m.TenantID }
+func (m MissionChatMessage) Tenant() string { return m.TenantID }
+func (r OutboxRecord) Tenant() string       { return r.TenantID }
+func (c TacticalCommand) Tenant() string    { return c.TenantID }
+func (t Team) Tenant() string               { return t.TenantID }
+func (u User) Tenant() string               { return u.TenantID }
+func (s UserSession) Tenant() string        { return s.TenantID }
+
+// CheckSameTenant returns ErrCrossTenant if a referenced record belongs to another
+// tenant than the record referencing it
+func CheckSameTenant(owner TenantScoped, refs ...TenantScoped) error {
+	for _, ref := range refs {
+		if ref.Tenant() != owner.Tenant() {
+			return fmt.Errorf("%w: %T of tenant %q references %T of tenant %q",
+				ErrCrossTenant, owner, owner.Tenant(), ref, ref.Tenant())
+		}
+	}
+	return nil
+}
+
+// CheckTargets returns ErrCrossTenant if the command's mission or one of the assets and
+// teams it targets belongs to another tenant
+func (c TacticalCommand) CheckTargets(mission Mission, assets []Asset, teams []Team) error {
+	if err := CheckSameTenant(c, mission); err != nil {
+		return fmt.Errorf("mission %s: %w", mission.ID.Hex(), err)
+	}
+	for _, a := range assets {
+		if err := CheckSameTenant(c, a); err != nil {
+			return fmt.Errorf("asset %s: %w", a.ID, err)
+		}
+	}
+	for _, t := range teams {
+		if err := CheckSameTenant(c, t); err != nil {
+			return fmt.Errorf("team %s: %w", t.ID.Hex(), err)
+		}
+	}
+	return nil
+}
@@ -21,9 +21,10 @@ const (
 // User represents a user in the system with role-based access
 type User struct {
 	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Email        string             `bson:"email" json:"email"`
-	PasswordHash string             `bson:"password_hash" json:"-"` // Never expose in JSON
-	Name         string             `bson:"name" json:"name"`
+	TenantID     string             `bson:"tenant_id,omitempty" json:"tenantId,omitempty"`
+	Email        string             `bson:"email" json:"email" sensitivity:"pii"`
+	PasswordHash string             `bson:"password_hash" json:"-" sensitivity:"sensitive"` // Never expose in JSON
+	Name         string             `bson:"name" json:"name" sensitivity:"pii"`
 	Role         UserRole           `bson:"role" json:"role"`
 	AssetID      string             `bson:"asset_id,omitempty" json:"assetId,omitempty"` // For field agents
 	Active       bool               `bson:"active" json:"active"`
@@ -36,12 +37,13 @@ type User struct {
 // UserSession represents an active user session
 type UserSession struct {
 	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	TenantID  string             `bson:"tenant_id,omitempty" json:"tenantId,omitempty"`
 	UserID    primitive.ObjectID `bson:"user_id" json:"userId"`
-	Token     string             `bson:"token" json:"token"`
+	Token     string             `bson:"token" json:"token" sensitivity:"sensitive"`
 	ExpiresAt time.Time          `bson:"expires_at" json:"expiresAt"`
 	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
-	IPAddress string             `bson:"ip_address,omitempty" json:"ipAddress,omitempty"`
-	UserAgent string             `bson:"user_agent,omitempty" json:"userAgent,omitempty"`
+	IPAddress string             `bson:"ip_address,omitempty" json:"ipAddress,omitempty" sensitivity:"pii"`
+	UserAgent string             `bson:"user_agent,omitempty" json:"userAgent,omitempty" sensitivity:"pii"`
 }
 
 // HasRole checks if the user has the specified role
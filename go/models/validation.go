@@ -0,0 +1,37 @@
+package models
+
+import "strings"
+
+// FieldError describes one invalid field, addressed by its JSON path (e.g. "location.latitude")
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors collects every invalid field of a model or event payload
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+	return "invalid fields: " + strings.Join(msgs, "; ")
+}
+
+// add records an invalid field
+func (e *ValidationErrors) add(field, message string) {
+	*e = append(*e, FieldError{Field: field, Message: message})
+}
+
+// err returns the collected errors, or nil when there are none
+func (e ValidationErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
@@ -12,6 +12,7 @@ class Alert(BaseModel):
     """Alert represents a system alert"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenant_id")
     type: str  # "fire_risk", "asset_danger", "weather_warning"
     severity: str  # "low", "medium", "high", "critical"
     location_id: str = Field(alias="location_id")
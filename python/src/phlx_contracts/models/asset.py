@@ -18,6 +18,7 @@ class Asset(BaseModel):
     """Asset represents an asset in the system"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     name: str
     type: str
     status: str
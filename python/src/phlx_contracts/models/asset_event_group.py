@@ -21,6 +21,7 @@ class Event(BaseModel):
     """Single event within a group"""
 
     id: str
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     type: str
     timestamp: str
     location: Optional[GeoJSONPoint] = None
@@ -36,6 +37,7 @@ class AssetEventGroup(BaseModel):
     """Grouped events by asset"""
 
     asset_id: str = Field(alias="assetId")
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     asset_name: str = Field(alias="assetName")
     asset_type: str = Field(alias="assetType")  # "camera" or "fire_detector"
     event_count: int = Field(alias="eventCount")
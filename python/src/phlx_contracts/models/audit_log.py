@@ -44,6 +44,7 @@ class AuditLog(BaseModel):
     """Audit log entry"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     mission_id: str = Field(alias="missionId")
     timestamp: datetime
 
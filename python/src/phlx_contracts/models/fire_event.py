@@ -50,6 +50,7 @@ class FireEvent(BaseModel):
     """Fire event model"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenant_id")
     location_id: str = Field(alias="location_id")
     location_name: str = Field(alias="location_name")
     location_type: str = Field(alias="location_type")  # "asset", "facility"
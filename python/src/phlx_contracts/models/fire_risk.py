@@ -13,6 +13,7 @@ class MonitoredLocation(BaseModel):
     """Monitored location model"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenant_id")
     name: str
     type: str  # "asset", "facility", etc.
     location: GeoPoint
@@ -26,6 +27,7 @@ class FireData(BaseModel):
     """Fire data model"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenant_id")
     source: str
     source_type: str = Field(alias="source_type")
     timestamp: datetime
@@ -22,6 +22,7 @@ class Area(BaseModel):
     """Area represents a named zone within a location with polygon boundary"""
 
     id: str
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     name: str
     description: Optional[str] = None
     boundary: List[Coordinate]  # Polygon points
@@ -42,6 +43,7 @@ class Location(BaseModel):
     """Location represents a geographic location with center coordinates and multiple areas"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     name: str
     description: Optional[str] = None
 
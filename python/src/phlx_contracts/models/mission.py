@@ -22,6 +22,7 @@ class Mission(BaseModel):
     """Mission represents an operator-managed incident with correlated events"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     title: str
     description: str
     status: MissionStatus
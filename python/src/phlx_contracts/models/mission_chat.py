@@ -12,6 +12,7 @@ class MissionChatMessage(BaseModel):
     """Mission chat message model"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     mission_id: str = Field(alias="missionId")
     sender_id: str = Field(alias="senderId")
     sender_name: str = Field(alias="senderName")
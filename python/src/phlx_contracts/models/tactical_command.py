@@ -115,6 +115,7 @@ class TacticalCommand(BaseModel):
     """Tactical command model"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenant_id")
 
     # Mission Context
     mission_id: str = Field(alias="mission_id")
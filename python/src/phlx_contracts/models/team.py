@@ -22,6 +22,7 @@ class Team(BaseModel):
     """Team represents a group of assets working together"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     name: str
     description: Optional[str] = None
     status: TeamStatus
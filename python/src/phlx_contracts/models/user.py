@@ -21,6 +21,7 @@ class User(BaseModel):
     """User represents a user in the system with role-based access"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     email: str
     password_hash: Optional[str] = Field(default=None, exclude=True)  # Never expose in JSON
     name: str
@@ -69,6 +70,7 @@ class UserSession(BaseModel):
     """User session model"""
 
     id: str = Field(alias="id")
+    tenant_id: Optional[str] = Field(default=None, alias="tenantId")
     user_id: str = Field(alias="userId")
     token: str
     expires_at: datetime = Field(alias="expiresAt")
@@ -4,6 +4,9 @@
  */
 
 import { CommandTarget } from '../models/TacticalCommand';
+import { Alert } from '../models/Alert';
+import { FireEvent } from '../models/FireEvent';
+import { AssetRecallReasonType } from '../models/Asset';
 
 /** ImageBoundingBox represents a rectangular area in an image (pixel coordinates) */
 export interface ImageBoundingBox {
@@ -38,6 +41,9 @@ export const EventType = {
   TACTICAL_COMMAND_STATUS_CHANGED: 'tactical_command_status_changed' as const,
   TACTICAL_SUGGESTION_CREATED: 'tactical_suggestion_created' as const,
   FIRE_ALERT_CREATED: 'fire.alert.created' as const,
+  FIRE_RISK_DETECTED: 'fire.risk.detected' as const,
+  FIRE_RISK_UPDATED: 'fire.risk.updated' as const,
+  FIRE_RISK_CLEARED: 'fire.risk.cleared' as const,
   MISSION_CHAT_MESSAGE: 'mission_chat_message' as const,
   MISSION_TYPING_INDICATOR: 'mission_typing_indicator' as const,
 };
@@ -49,6 +55,26 @@ export interface BaseEvent {
   type: EventTypeValue;
   timestamp: string;
   source: string;
+  schemaVersion?: string;
+  correlationId?: string; // ID of the root event of the incident
+  causationId?: string; // ID of the event that caused this one
+  traceparent?: string; // W3C Trace Context
+  tracestate?: string;
+  aggregateId?: string; // e.g. the command ID of tactical command events
+  sequence?: number; // position in the aggregate's event stream, from 1
+  tenantId?: string; // organisation the event belongs to
+  encryption?: FieldEncryption; // encrypted sensitive fields; they are absent from the payload
+}
+
+/**
+ * Sensitive fields encrypted by the producer (events.FieldEncryptor in Go). Decrypting
+ * requires the key-encryption key keyId.
+ */
+export interface FieldEncryption {
+  keyId: string;
+  dataKey: string; // base64, wrapped AES-256 data key
+  fields: string[]; // JSON paths of the encrypted fields, e.g. "pulseRate"
+  ciphertext: string; // base64, AES-GCM nonce followed by the sealed fields
 }
 
 
@@ -73,9 +99,14 @@ export interface AssetUpdateEventData extends BaseEvent {
 export interface AssetRecallEventData extends BaseEvent {
   assetId: string;
   assetName: string;
+  missionId?: string; // Mission the asset is pulled from
+  reason: AssetRecallReasonType;
+  notes?: string;
+  returnBaseId: string;
+  returnBaseName?: string;
+  returnBy: string; // ISO 8601 deadline for arriving at base
   recalledBy: string;
   recalledByName: string;
-  reason: string;
   location?: LocationData;
 }
 
@@ -113,8 +144,8 @@ export interface LocationUpdateEventData extends BaseEvent {
 export interface VitalsUpdateEventData extends BaseEvent {
   personnelId: string;
   personnelName: string;
-  pulseRate: number;
-  oxygenLevel: number;
+  pulseRate?: number; // absent when missing or encrypted
+  oxygenLevel?: number; // absent when missing or encrypted
   temperature?: number;
   isAlert: boolean;
   alertReason?: string;
@@ -305,6 +336,11 @@ export interface FireAlertCreatedEventData extends BaseEvent {
   message: string;
   riskScore: number;
   location?: LocationData;
+  alert: Alert;
+}
+
+export interface FireRiskEventData extends BaseEvent {
+  fireEvent: FireEvent;
 }
 
 export interface MissionChatMessageEventData extends BaseEvent {
@@ -5,10 +5,13 @@
 
 import { FireEvent } from '../models/FireEvent';
 
+/** Fire risk event types carried by FireEventSchema */
+export type FireEventType = 'fire.risk.detected' | 'fire.risk.updated' | 'fire.risk.cleared';
+
 /** FireEventSchema defines the Kafka message schema for fire events */
 export interface FireEventSchema {
   schema_version: string; // "1.0"
-  event_type: string; // "fire.risk.detected", "fire.risk.updated", "fire.risk.cleared"
+  event_type: FireEventType;
   timestamp: string; // ISO 8601
   payload: FireEvent;
 }
@@ -5,3 +5,4 @@
 export * from './events';
 export * from './topics';
 export * from './fireEvents';
+export * from './websocket';
@@ -22,6 +22,15 @@ export const KafkaTopics = {
   MISSION_EVENTS: 'mission-events',
   AI_MISSION_SUGGESTIONS: 'ai-mission-suggestions',
   MISSION_CHAT: 'mission-chat',
+  FIRE_EVENTS: 'fire-events',
 } as const;
 
 export type KafkaTopicName = typeof KafkaTopics[keyof typeof KafkaTopics];
+
+/**
+ * Topic a tenant's events are published to when the environment uses tenant topics
+ * (events.TenantTopics in Go), e.g. "agency-b.mission-events"
+ */
+export function tenantTopic(tenantId: string, topic: KafkaTopicName): string {
+  return tenantId ? `${tenantId}.${topic}` : topic;
+}
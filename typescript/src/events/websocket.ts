@@ -0,0 +1,104 @@
+/**
+ * Communication hub WebSocket protocol (GET /ws)
+ * Converted from go/events/websocket.go
+ */
+
+import { EventTypeValue } from './events';
+
+export const WebSocketFrameType = {
+  SUBSCRIBE: 'subscribe' as const,
+  UNSUBSCRIBE: 'unsubscribe' as const,
+  RESUME: 'resume' as const,
+  ACK: 'ack' as const,
+  PING: 'ping' as const,
+  PONG: 'pong' as const,
+  ERROR: 'error' as const,
+  EVENT: 'event' as const,
+};
+
+export type WebSocketFrameTypeValue = typeof WebSocketFrameType[keyof typeof WebSocketFrameType];
+
+export const WebSocketErrorCode = {
+  INVALID_FRAME: 'invalid_frame' as const,
+  UNAUTHORIZED: 'unauthorized' as const,
+  UNKNOWN_SUBSCRIPTION: 'unknown_subscription' as const,
+  OFFSET_EXPIRED: 'offset_expired' as const,
+  RATE_LIMITED: 'rate_limited' as const,
+  INTERNAL: 'internal' as const,
+};
+
+export type WebSocketErrorCodeValue = typeof WebSocketErrorCode[keyof typeof WebSocketErrorCode];
+
+/** Milliseconds between pings on an idle connection */
+export const WEBSOCKET_PING_INTERVAL_MS = 30000;
+/** Milliseconds to wait for a pong before closing the connection */
+export const WEBSOCKET_PONG_TIMEOUT_MS = 10000;
+
+/** Every set dimension must match; within a dimension any listed value matches */
+export interface SubscriptionFilter {
+  missionIds?: string[];
+  areaIds?: string[];
+  eventTypes?: EventTypeValue[];
+}
+
+export interface SubscribeFrame {
+  type: 'subscribe';
+  requestId: string;
+  subscriptionId: string; // chosen by the client, reused after a reconnect
+  filter: SubscriptionFilter;
+}
+
+export interface UnsubscribeFrame {
+  type: 'unsubscribe';
+  requestId: string;
+  subscriptionId: string;
+}
+
+export interface ResumeFrame {
+  type: 'resume';
+  requestId: string;
+  subscriptionId: string;
+  offset: number; // last offset processed; the hub replays the events after it
+}
+
+/** Sent by the hub for a request (requestId) and by clients for events (subscriptionId + offset) */
+export interface AckFrame {
+  type: 'ack';
+  requestId?: string;
+  subscriptionId?: string;
+  offset?: number;
+}
+
+export interface PingFrame {
+  type: 'ping';
+  timestamp: string;
+}
+
+export interface PongFrame {
+  type: 'pong';
+  timestamp: string; // timestamp of the ping being answered
+}
+
+export interface ErrorFrame {
+  type: 'error';
+  requestId?: string;
+  subscriptionId?: string;
+  code: WebSocketErrorCodeValue;
+  message: string;
+}
+
+export interface WebSocketMessage<T = unknown> {
+  type: 'event';
+  event: EventTypeValue;
+  data: T;
+  timestamp: string;
+  clientId?: string;
+  subscriptionId?: string;
+  offset?: number;
+}
+
+export type ClientFrame = SubscribeFrame | UnsubscribeFrame | ResumeFrame | AckFrame | PingFrame | PongFrame;
+
+export type ServerFrame = AckFrame | PingFrame | PongFrame | ErrorFrame | WebSocketMessage;
+
+export type WebSocketFrame = ClientFrame | ServerFrame;
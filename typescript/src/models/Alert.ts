@@ -7,6 +7,7 @@
 
 export interface Alert {
   id: string;
+  tenant_id?: string;
   type: string; // "fire_risk", "asset_danger", "weather_warning"
   severity: string; // "low", "medium", "high", "critical"
   location_id: string;
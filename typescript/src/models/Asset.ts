@@ -18,6 +18,7 @@ export type AssetStatusType = typeof AssetStatus[keyof typeof AssetStatus];
 /** Asset represents an asset in the system */
 export interface Asset {
   id: string;
+  tenantId?: string;
   name: string;
   type: string;
   status: string;
@@ -41,3 +42,30 @@ export interface Asset {
   metadata?: Record<string, any>;
   autoPositionEnabled: boolean; // When false, position simulator skips this asset
 }
+
+/** Reasons an asset can be recalled to base */
+export const AssetRecallReason = {
+  MISSION_COMPLETE: 'mission_complete' as const, // Mission no longer needs the asset
+  LOW_BATTERY: 'low_battery' as const,           // Battery below safe return threshold
+  VITALS_ALERT: 'vitals_alert' as const,         // Personnel vitals out of range
+  WEATHER: 'weather' as const,                   // Unsafe weather conditions
+  MAINTENANCE: 'maintenance' as const,           // Asset needs servicing
+  OPERATOR_ORDER: 'operator_order' as const,     // Operator decision, see notes
+};
+
+export type AssetRecallReasonType = typeof AssetRecallReason[keyof typeof AssetRecallReason];
+
+/** AssetRecall represents an order for a dispatched asset to return to base */
+export interface AssetRecall {
+  tenantId?: string;
+  assetId: string;
+  missionId?: string;
+  reason: AssetRecallReasonType;
+  notes?: string;
+  returnBaseId: string;
+  returnBaseName?: string;
+  returnBy: string; // ISO 8601
+  recalledBy: string;
+  recalledByName: string;
+  issuedAt: string; // ISO 8601
+}
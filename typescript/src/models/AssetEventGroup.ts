@@ -14,6 +14,7 @@ export interface GeoJSONPoint {
 /** Event represents a single event within a group */
 export interface Event {
   id: string;
+  tenantId?: string;
   type: string;
   timestamp: string; // ISO 8601
   location?: GeoJSONPoint;
@@ -25,6 +26,7 @@ export interface Event {
 /** AssetEventGroup represents grouped events by asset (camera or fire_detector) */
 export interface AssetEventGroup {
   assetId: string;
+  tenantId?: string;
   assetName: string;
   assetType: string; // "camera" or "fire_detector"
   eventCount: number;
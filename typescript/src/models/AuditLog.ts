@@ -40,6 +40,7 @@ export type AuditActionTypeValue = typeof AuditActionType[keyof typeof AuditActi
 /** AuditLog represents a complete audit trail entry for mission actions */
 export interface AuditLog {
   id: string;
+  tenantId?: string;
   missionId: string;
   timestamp: string; // ISO 8601
 
@@ -30,6 +30,7 @@ export interface ScoreFactors {
 
 export interface FireEvent {
   id: string;
+  tenant_id?: string;
   location_id: string;
   location_name: string;
   location_type: string; // "asset", "facility"
@@ -9,6 +9,7 @@ import { GeoPoint } from './common';
 
 export interface MonitoredLocation {
   id: string;
+  tenant_id?: string;
   name: string;
   type: string; // "asset", "facility", etc.
   location: GeoPoint;
@@ -17,6 +18,7 @@ export interface MonitoredLocation {
 
 export interface FireData {
   id: string;
+  tenant_id?: string;
   source: string;
   source_type: string;
   timestamp: string; // ISO 8601
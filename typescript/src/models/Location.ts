@@ -10,6 +10,7 @@ import { Coordinate } from './common';
 /** Area represents a named zone within a location with polygon boundary */
 export interface Area {
   id: string; // UUID within location
+  tenantId?: string;
   name: string;
   description?: string;
   boundary: Coordinate[]; // Polygon points
@@ -26,6 +27,7 @@ export interface Area {
 /** Location represents a geographic location with center coordinates and multiple areas */
 export interface Location {
   id: string;
+  tenantId?: string;
   name: string;
   description?: string;
 
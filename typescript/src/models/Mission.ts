@@ -19,6 +19,7 @@ export type MissionStatusType = typeof MissionStatus[keyof typeof MissionStatus]
 /** Mission represents an operator-managed incident with correlated events */
 export interface Mission {
   id: string;
+  tenantId?: string;
   title: string;
   description: string;
   status: MissionStatusType;
@@ -8,6 +8,7 @@
 /** MissionChatMessage represents a human chat message in a mission */
 export interface MissionChatMessage {
   id: string;
+  tenantId?: string;
   missionId: string;
   senderId: string;
   senderName: string;
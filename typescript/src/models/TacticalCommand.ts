@@ -92,6 +92,7 @@ export interface TacticalGeoArea {
 /** TacticalCommand represents a unified command model replacing OperationalCommand and DispatchRequest */
 export interface TacticalCommand {
   id: string;
+  tenant_id?: string;
 
   // Mission Context (required - commands must link to existing missions)
   mission_id: string;
@@ -20,6 +20,7 @@ export type TeamStatusType = typeof TeamStatus[keyof typeof TeamStatus];
 /** Team represents a group of assets working together */
 export interface Team {
   id: string;
+  tenantId?: string;
   name: string;
   description?: string;
   status: TeamStatusType;
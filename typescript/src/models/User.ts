@@ -17,6 +17,7 @@ export type UserRoleType = typeof UserRole[keyof typeof UserRole];
 /** User represents a user in the system with role-based access */
 export interface User {
   id: string;
+  tenantId?: string;
   email: string;
   // passwordHash is never exposed in JSON
   name: string;
@@ -32,6 +33,7 @@ export interface User {
 /** UserSession represents an active user session */
 export interface UserSession {
   id: string;
+  tenantId?: string;
   userId: string;
   token: string;
   expiresAt: string; // ISO 8601